@@ -0,0 +1,173 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+var (
+	ErrAuditChainBroken      = errors.New("audit log hash chain is broken")
+	ErrAuditSignatureInvalid = errors.New("audit log signature is invalid")
+)
+
+type AuditSink struct {
+	Writer     io.Writer
+	SigningKey ed25519.PrivateKey
+	SignEvery  int
+	LastSeq    uint64
+	LastHash   string
+
+	mu       sync.Mutex
+	unsigned bool
+}
+
+type auditRecord struct {
+	Seq        uint64          `json:"seq"`
+	Prev       string          `json:"prev"`
+	Hash       string          `json:"hash"`
+	Signature  string          `json:"sig,omitempty"`
+	Checkpoint bool            `json:"checkpoint,omitempty"`
+	Entry      json.RawMessage `json:"entry"`
+}
+
+type AuditVerifyOptions struct {
+	PublicKey  ed25519.PublicKey
+	SignEvery  int
+	AnchorSeq  uint64
+	AnchorHash string
+}
+
+func (a *AuditSink) Write(entry Entry) error {
+	body := bytes.TrimRight(encodeJSON(entry), "\n")
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seq := a.LastSeq + 1
+	hash := auditHash(seq, a.LastHash, body)
+	signed := a.SigningKey != nil && seq%uint64(auditSignEvery(a.SignEvery)) == 0
+
+	var line bytes.Buffer
+	line.WriteString(`{"seq":` + strconv.FormatUint(seq, 10))
+	line.WriteString(`,"prev":"` + a.LastHash + `"`)
+	line.WriteString(`,"hash":"` + hash + `"`)
+	if signed {
+		line.WriteString(`,"sig":"` + a.sign(hash) + `"`)
+	}
+	line.WriteString(`,"entry":`)
+	line.Write(body)
+	line.WriteString("}\n")
+
+	if _, err := a.Writer.Write(line.Bytes()); err != nil {
+		return err
+	}
+	a.LastSeq = seq
+	a.LastHash = hash
+	a.unsigned = !signed
+	return nil
+}
+
+func (a *AuditSink) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.SigningKey != nil && a.unsigned {
+		checkpoint := `{"seq":` + strconv.FormatUint(a.LastSeq, 10) + `,"hash":"` + a.LastHash + `","sig":"` + a.sign(a.LastHash) + `","checkpoint":true}` + "\n"
+		if _, err := io.WriteString(a.Writer, checkpoint); err != nil {
+			return err
+		}
+		a.unsigned = false
+	}
+	if closer, ok := a.Writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (a *AuditSink) sign(hash string) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(a.SigningKey, []byte(hash)))
+}
+
+func auditSignEvery(n int) int {
+	if n <= 0 {
+		return 1
+	}
+	return n
+}
+
+func auditHash(seq uint64, prev string, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%s\n", seq, prev)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func VerifyAuditLog(r io.Reader, opts AuditVerifyOptions) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+
+	var (
+		verified int
+		lastSeq  = opts.AnchorSeq
+		lastHash = opts.AnchorHash
+		signed   bool
+	)
+	signEvery := uint64(auditSignEvery(opts.SignEvery))
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return verified, fmt.Errorf("record %d: %w", verified+1, err)
+		}
+		if record.Checkpoint {
+			if record.Seq != lastSeq || record.Hash != lastHash {
+				return verified, fmt.Errorf("%w at checkpoint %d", ErrAuditChainBroken, record.Seq)
+			}
+			if opts.PublicKey != nil {
+				if !verifyAuditSignature(opts.PublicKey, record) {
+					return verified, fmt.Errorf("%w at checkpoint %d", ErrAuditSignatureInvalid, record.Seq)
+				}
+				signed = true
+			}
+			continue
+		}
+		if record.Seq != lastSeq+1 || record.Prev != lastHash {
+			return verified, fmt.Errorf("%w at seq %d", ErrAuditChainBroken, record.Seq)
+		}
+		if auditHash(record.Seq, record.Prev, record.Entry) != record.Hash {
+			return verified, fmt.Errorf("%w at seq %d: hash mismatch", ErrAuditChainBroken, record.Seq)
+		}
+		if opts.PublicKey != nil {
+			signed = record.Signature != ""
+			if (signed || record.Seq%signEvery == 0) && !verifyAuditSignature(opts.PublicKey, record) {
+				return verified, fmt.Errorf("%w at seq %d", ErrAuditSignatureInvalid, record.Seq)
+			}
+		}
+		verified++
+		lastSeq = record.Seq
+		lastHash = record.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return verified, err
+	}
+	if opts.PublicKey != nil && verified > 0 && !signed {
+		return verified, fmt.Errorf("%w: final record at seq %d is not signed", ErrAuditSignatureInvalid, lastSeq)
+	}
+	return verified, nil
+}
+
+func verifyAuditSignature(publicKey ed25519.PublicKey, record auditRecord) bool {
+	sig, err := base64.StdEncoding.DecodeString(record.Signature)
+	return err == nil && ed25519.Verify(publicKey, []byte(record.Hash), sig)
+}
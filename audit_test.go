@@ -0,0 +1,228 @@
+package logger
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	auditTestSeed, _ = hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	auditHashField   = regexp.MustCompile(`"hash":"[0-9a-f]*"`)
+	auditSigField    = regexp.MustCompile(`,"sig":"[^"]*"`)
+)
+
+func writeAuditLog(t *testing.T, sink *AuditSink, messages ...string) {
+	t.Helper()
+	for i, message := range messages {
+		entry := Entry{
+			Level:   INFO,
+			Time:    time.Date(2026, 1, 2, 3, 4, i, 0, time.UTC),
+			Service: "billing",
+			Message: message,
+			Fields:  Fields{"amount": 100 + i},
+		}
+		if err := sink.Write(entry); err != nil {
+			t.Fatalf("Write(%q): %v", message, err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func auditLines(buf *bytes.Buffer) []string {
+	return strings.SplitAfter(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+func TestAuditHashKnownVectors(t *testing.T) {
+	tests := []struct {
+		seq  uint64
+		prev string
+		body string
+		want string
+	}{
+		{1, "", `{"message":"opened"}`, "de250d3b4ca6a0a6166408996a04cec96eb66c23fbcfd6e65e10ce6165368d89"},
+		{2, "abc", `{"message":"closed"}`, "7b2cf24121d5a812d7bca1658c12789433af2a1ed383fd126031122f969a3972"},
+	}
+	for _, tt := range tests {
+		if got := auditHash(tt.seq, tt.prev, []byte(tt.body)); got != tt.want {
+			t.Errorf("auditHash(%d, %q, %s) = %s, want %s", tt.seq, tt.prev, tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestAuditSignKnownVector(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(auditTestSeed)
+	wantPublic := "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+	if got := hex.EncodeToString(key.Public().(ed25519.PublicKey)); got != wantPublic {
+		t.Fatalf("public key = %s, want %s", got, wantPublic)
+	}
+
+	sink := &AuditSink{SigningKey: key}
+	want := "5VZDAMNgrHKQhuLMgG6CioSHfx645dl02HPgZSJJAVVfuIIVkKM7rMYeOXAc+bRr0lv18FlbviRlUUFDjnoQCw=="
+	if got := sink.sign(""); got != want {
+		t.Errorf("sign(\"\") = %s, want %s", got, want)
+	}
+}
+
+func TestAuditRoundTrip(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(auditTestSeed)
+	public := key.Public().(ed25519.PublicKey)
+
+	tests := []struct {
+		name      string
+		key       ed25519.PrivateKey
+		signEvery int
+		verify    AuditVerifyOptions
+	}{
+		{"unsigned", nil, 0, AuditVerifyOptions{}},
+		{"every record signed", key, 1, AuditVerifyOptions{PublicKey: public, SignEvery: 1}},
+		{"checkpointed", key, 3, AuditVerifyOptions{PublicKey: public, SignEvery: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeAuditLog(t, &AuditSink{Writer: &buf, SigningKey: tt.key, SignEvery: tt.signEvery},
+				"opened", "debited", "credited", "reconciled", "closed")
+
+			n, err := VerifyAuditLog(&buf, tt.verify)
+			if err != nil {
+				t.Fatalf("VerifyAuditLog: %v", err)
+			}
+			if n != 5 {
+				t.Errorf("verified %d records, want 5", n)
+			}
+		})
+	}
+}
+
+func TestAuditResumeFromAnchor(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(auditTestSeed)
+	public := key.Public().(ed25519.PublicKey)
+
+	var first bytes.Buffer
+	sink := &AuditSink{Writer: &first, SigningKey: key, SignEvery: 2}
+	writeAuditLog(t, sink, "opened", "debited", "credited")
+
+	var second bytes.Buffer
+	resumed := &AuditSink{Writer: &second, SigningKey: key, SignEvery: 2, LastSeq: sink.LastSeq, LastHash: sink.LastHash}
+	writeAuditLog(t, resumed, "reconciled", "closed")
+
+	opts := AuditVerifyOptions{PublicKey: public, SignEvery: 2, AnchorSeq: sink.LastSeq, AnchorHash: sink.LastHash}
+	if n, err := VerifyAuditLog(&second, opts); err != nil || n != 2 {
+		t.Fatalf("VerifyAuditLog from anchor = %d, %v; want 2, nil", n, err)
+	}
+
+	second.Reset()
+	resumed = &AuditSink{Writer: &second, SigningKey: key, SignEvery: 2, LastSeq: sink.LastSeq, LastHash: sink.LastHash}
+	writeAuditLog(t, resumed, "reconciled")
+	if _, err := VerifyAuditLog(&second, AuditVerifyOptions{PublicKey: public, SignEvery: 2}); !errors.Is(err, ErrAuditChainBroken) {
+		t.Errorf("VerifyAuditLog without anchor = %v, want ErrAuditChainBroken", err)
+	}
+}
+
+func TestAuditTamperDetection(t *testing.T) {
+	key := ed25519.NewKeyFromSeed(auditTestSeed)
+	public := key.Public().(ed25519.PublicKey)
+	other := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{7}, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+
+	var buf bytes.Buffer
+	writeAuditLog(t, &AuditSink{Writer: &buf, SigningKey: key, SignEvery: 2},
+		"opened", "debited", "credited", "reconciled", "closed")
+	lines := auditLines(&buf)
+	if len(lines) != 6 {
+		t.Fatalf("got %d lines, want 5 records and a checkpoint:\n%s", len(lines), buf.String())
+	}
+
+	tests := []struct {
+		name   string
+		tamper func(lines []string) []string
+		opts   AuditVerifyOptions
+		want   error
+	}{
+		{
+			name: "edited entry",
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], `"amount":101`, `"amount":901`, 1)
+				return lines
+			},
+			want: ErrAuditChainBroken,
+		},
+		{
+			name: "edited entry with recomputed hash",
+			tamper: func(lines []string) []string {
+				lines[0] = strings.Replace(lines[0], `"opened"`, `"forged"`, 1)
+				body := lines[0][strings.Index(lines[0], `"entry":`)+len(`"entry":`) : len(lines[0])-2]
+				lines[0] = auditHashField.ReplaceAllString(lines[0], `"hash":"`+auditHash(1, "", []byte(body))+`"`)
+				return lines
+			},
+			want: ErrAuditChainBroken,
+		},
+		{
+			name: "deleted record",
+			tamper: func(lines []string) []string {
+				return append(lines[:2:2], lines[3:]...)
+			},
+			want: ErrAuditChainBroken,
+		},
+		{
+			name: "reordered records",
+			tamper: func(lines []string) []string {
+				lines[1], lines[2] = lines[2], lines[1]
+				return lines
+			},
+			want: ErrAuditChainBroken,
+		},
+		{
+			name: "stripped signature",
+			tamper: func(lines []string) []string {
+				lines[1] = auditSigField.ReplaceAllString(lines[1], "")
+				return lines
+			},
+			opts: AuditVerifyOptions{PublicKey: public, SignEvery: 2},
+			want: ErrAuditSignatureInvalid,
+		},
+		{
+			name: "truncated tail",
+			tamper: func(lines []string) []string {
+				return lines[:3]
+			},
+			opts: AuditVerifyOptions{PublicKey: public, SignEvery: 2},
+			want: ErrAuditSignatureInvalid,
+		},
+		{
+			name: "forged checkpoint",
+			tamper: func(lines []string) []string {
+				lines[5] = strings.Replace(lines[5], `"seq":5`, `"seq":4`, 1)
+				return lines
+			},
+			opts: AuditVerifyOptions{PublicKey: public, SignEvery: 2},
+			want: ErrAuditChainBroken,
+		},
+		{
+			name:   "wrong public key",
+			tamper: func(lines []string) []string { return lines },
+			opts:   AuditVerifyOptions{PublicKey: other, SignEvery: 2},
+			want:   ErrAuditSignatureInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tampered := strings.Join(tt.tamper(append([]string(nil), lines...)), "")
+			_, err := VerifyAuditLog(strings.NewReader(tampered), tt.opts)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifyAuditLog = %v, want %v\n%s", err, tt.want, tampered)
+			}
+		})
+	}
+
+	if n, err := VerifyAuditLog(strings.NewReader(strings.Join(lines, "")), AuditVerifyOptions{PublicKey: public, SignEvery: 2}); err != nil || n != 5 {
+		t.Fatalf("untampered log = %d, %v; want 5, nil", n, err)
+	}
+}
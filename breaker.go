@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("webhook circuit breaker is open")
+
+type CircuitBreaker struct {
+	FailureThreshold int
+	OpenDuration     time.Duration
+}
+
+type breakerState struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (d *webhookDispatcher) breakerAllows(url string, breaker *CircuitBreaker) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state := d.breakers[url]
+	if state == nil || state.openUntil.IsZero() {
+		return true
+	}
+	if state.probing || time.Now().Before(state.openUntil) {
+		return false
+	}
+	state.probing = true
+	return true
+}
+
+func (d *webhookDispatcher) breakerResult(url string, breaker *CircuitBreaker, err error) {
+	d.mu.Lock()
+	state := d.breakers[url]
+	if state == nil {
+		state = &breakerState{}
+		d.breakers[url] = state
+	}
+
+	if err == nil {
+		recovered := !state.openUntil.IsZero()
+		*state = breakerState{}
+		d.mu.Unlock()
+		if recovered {
+			d.logger.logInternal("Webhook circuit breaker for %s closed\n", url)
+		}
+		return
+	}
+
+	threshold := breaker.FailureThreshold
+	if threshold <= 0 {
+		threshold = 5
+	}
+	openDuration := breaker.OpenDuration
+	if openDuration <= 0 {
+		openDuration = 30 * time.Second
+	}
+
+	state.failures++
+	wasOpen := !state.openUntil.IsZero()
+	state.probing = false
+	if state.failures >= threshold || wasOpen {
+		state.openUntil = time.Now().Add(openDuration)
+	}
+	opened := !wasOpen && !state.openUntil.IsZero()
+	d.mu.Unlock()
+
+	if opened {
+		d.logger.logInternal("Webhook circuit breaker for %s opened after %d failures\n", url, threshold)
+	}
+}
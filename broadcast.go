@@ -0,0 +1,108 @@
+package logger
+
+import (
+	"sync"
+)
+
+const defaultSubscriberBuffer = 256
+
+type Broadcaster struct {
+	BufferSize     int
+	AllowedOrigins []string
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+type subscriber struct {
+	entries chan Entry
+
+	mu     sync.Mutex
+	filter streamFilter
+}
+
+type streamFilter struct {
+	Level   string `json:"level"`
+	Context string `json:"context"`
+}
+
+func (f streamFilter) match(entry Entry) bool {
+	if f.Level != "" && levelRank(entry.Level) < levelRank(f.Level) {
+		return false
+	}
+	return f.Context == "" || entry.Context == f.Context
+}
+
+func (b *Broadcaster) Write(entry Entry) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrSinkClosed
+	}
+	for sub := range b.subscribers {
+		if !sub.matches(entry) {
+			continue
+		}
+		select {
+		case sub.entries <- entry:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *Broadcaster) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	for sub := range b.subscribers {
+		close(sub.entries)
+	}
+	b.subscribers = nil
+	return nil
+}
+
+func (b *Broadcaster) subscribe(filter streamFilter) *subscriber {
+	size := b.BufferSize
+	if size <= 0 {
+		size = defaultSubscriberBuffer
+	}
+	sub := &subscriber{entries: make(chan Entry, size), filter: filter}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.entries)
+		return sub
+	}
+	if b.subscribers == nil {
+		b.subscribers = make(map[*subscriber]struct{})
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (b *Broadcaster) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.entries)
+	}
+}
+
+func (s *subscriber) matches(entry Entry) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.filter.match(entry)
+}
+
+func (s *subscriber) setFilter(filter streamFilter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filter = filter
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const bugsnagEndpoint = "https://notify.bugsnag.com/"
+
+type BugsnagNotifier struct {
+	APIKey       string
+	ReleaseStage string
+	AppVersion   string
+	NotifyLevels []string
+	HTTPClient   *http.Client
+	Endpoint     string
+}
+
+func (b *BugsnagNotifier) Levels() []string {
+	if len(b.NotifyLevels) == 0 {
+		return []string{ERR, FATAL}
+	}
+	return b.NotifyLevels
+}
+
+func (b *BugsnagNotifier) Notify(ctx context.Context, entry Entry) error {
+	message := strings.TrimRight(entry.Message, "\n")
+
+	stacktrace := make([]map[string]any, 0, len(entry.Stack))
+	for _, frame := range entry.Stack {
+		stacktrace = append(stacktrace, map[string]any{
+			"file":       frame.File,
+			"lineNumber": frame.Line,
+			"method":     frame.Function,
+			"inProject":  !strings.HasPrefix(frame.Function, "runtime."),
+		})
+	}
+
+	releaseStage := b.ReleaseStage
+	if releaseStage == "" {
+		releaseStage = "production"
+	}
+	app := map[string]string{"releaseStage": releaseStage}
+	if b.AppVersion != "" {
+		app["version"] = b.AppVersion
+	}
+
+	metaData := map[string]any{"service": map[string]string{"name": entry.Service}}
+	if fields := jsonFields(entry.Fields); fields != nil {
+		metaData["fields"] = fields
+	}
+
+	event := map[string]any{
+		"exceptions": []map[string]any{{
+			"errorClass": firstLine(message),
+			"message":    message,
+			"stacktrace": stacktrace,
+			"type":       "go",
+		}},
+		"severity":       syslogScale(entry.Level, "error", "error", "warning", "info", "info"),
+		"unhandled":      entry.Level == FATAL,
+		"severityReason": map[string]string{"type": "log"},
+		"context":        entry.Context,
+		"app":            app,
+		"device": map[string]string{
+			"hostname": hostname(),
+			"time":     entry.Time.UTC().Format(time.RFC3339),
+		},
+		"metaData": metaData,
+	}
+
+	endpoint := b.Endpoint
+	if endpoint == "" {
+		endpoint = bugsnagEndpoint
+	}
+	return postJSON(ctx, b.HTTPClient, endpoint, map[string]string{
+		"Bugsnag-Api-Key":         b.APIKey,
+		"Bugsnag-Payload-Version": "5",
+		"Bugsnag-Sent-At":         time.Now().UTC().Format(time.RFC3339),
+	}, map[string]any{
+		"apiKey":         b.APIKey,
+		"payloadVersion": "5",
+		"notifier": map[string]string{
+			"name":    "gomessguii-logger",
+			"version": "1.0",
+			"url":     "https://github.com/gomessguii/logger",
+		},
+		"events": []any{event},
+	})
+}
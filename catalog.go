@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"bytes"
+	"sync"
+	"text/template"
+)
+
+const MessageKeyField = "message_key"
+
+type MessageCatalog struct {
+	DefaultLocale string
+	Messages      map[string]map[string]string
+
+	templates sync.Map
+}
+
+func (c *MessageCatalog) Render(locale string, key string, data Fields) (string, error) {
+	text, ok := c.lookup(locale, key)
+	if !ok {
+		return key, nil
+	}
+	cacheKey := locale + "\x00" + key
+	tmpl, ok := c.templates.Load(cacheKey)
+	if !ok {
+		parsed, err := template.New(key).Funcs(webhookTemplateFuncs).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return key, err
+		}
+		tmpl, _ = c.templates.LoadOrStore(cacheKey, parsed)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.(*template.Template).Execute(&buf, map[string]any(data)); err != nil {
+		return key, err
+	}
+	return buf.String(), nil
+}
+
+func (c *MessageCatalog) lookup(locale string, key string) (string, bool) {
+	for _, candidate := range []string{locale, c.DefaultLocale} {
+		if text, ok := c.Messages[candidate][key]; ok {
+			return text, true
+		}
+	}
+	return "", false
+}
+
+func (l *Logger) LogKey(logLevel string, key string, data Fields) {
+	message := key
+	if l.Catalog != nil {
+		rendered, err := l.Catalog.Render(l.Locale, key, data)
+		if err != nil {
+			l.logInternal("Failed to render message %q: %v\n", key, err)
+		}
+		message = rendered
+	}
+
+	capture := levelRank(logLevel) >= levelRank(ERR)
+	l.withRootFields(Fields{MessageKeyField: key}).WithFields(data).emitln(logLevel, []any{message}, l.WebhookConfig.sends(logLevel), capture)
+}
@@ -0,0 +1,209 @@
+package logger
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	cborUint   = 0 << 5
+	cborNegInt = 1 << 5
+	cborBytes  = 2 << 5
+	cborText   = 3 << 5
+	cborArray  = 4 << 5
+	cborMap    = 5 << 5
+	cborTag    = 6 << 5
+	cborSimple = 7 << 5
+)
+
+type CBOREncoder struct{}
+
+func (CBOREncoder) Encode(entry Entry) ([]byte, error) {
+	keys := entryFieldKeys(entry)
+	_, hasError := entry.Fields["error"]
+	n := 4 + len(keys)
+	if entry.Context != "" {
+		n++
+	}
+	if entry.Caller != nil {
+		n++
+	}
+	if entry.Err != nil && !hasError {
+		n++
+	}
+
+	b := make([]byte, 0, 128)
+	b = appendCBORHead(b, cborMap, uint64(n))
+	b = appendCBORText(b, "time")
+	b = appendCBORTime(b, entry.Time)
+	b = appendCBORText(b, "level")
+	b = appendCBORText(b, entry.Level)
+	b = appendCBORText(b, "service")
+	b = appendCBORText(b, entry.Service)
+	if entry.Context != "" {
+		b = appendCBORText(b, "context")
+		b = appendCBORText(b, entry.Context)
+	}
+	b = appendCBORText(b, "message")
+	b = appendCBORText(b, strings.TrimRight(entry.Message, "\n"))
+	if entry.Caller != nil {
+		b = appendCBORText(b, "caller")
+		b = appendCBORText(b, entry.Caller.String())
+	}
+	if entry.Err != nil && !hasError {
+		b = appendCBORText(b, "error")
+		b = appendCBORText(b, entry.Err.Error())
+	}
+	for _, k := range keys {
+		b = appendCBORText(b, k)
+		b = appendCBOR(b, entry.Fields[k])
+	}
+	return b, nil
+}
+
+func appendCBOR(b []byte, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(b, cborSimple|22)
+	case bool:
+		if v {
+			return append(b, cborSimple|21)
+		}
+		return append(b, cborSimple|20)
+	case int:
+		return appendCBORInt(b, int64(v))
+	case int8:
+		return appendCBORInt(b, int64(v))
+	case int16:
+		return appendCBORInt(b, int64(v))
+	case int32:
+		return appendCBORInt(b, int64(v))
+	case int64:
+		return appendCBORInt(b, v)
+	case uint:
+		return appendCBORHead(b, cborUint, uint64(v))
+	case uint8:
+		return appendCBORHead(b, cborUint, uint64(v))
+	case uint16:
+		return appendCBORHead(b, cborUint, uint64(v))
+	case uint32:
+		return appendCBORHead(b, cborUint, uint64(v))
+	case uint64:
+		return appendCBORHead(b, cborUint, v)
+	case float32:
+		b = append(b, cborSimple|26)
+		return binary.BigEndian.AppendUint32(b, math.Float32bits(v))
+	case float64:
+		b = append(b, cborSimple|27)
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(v))
+	case string:
+		return appendCBORText(b, v)
+	case []byte:
+		b = appendCBORHead(b, cborBytes, uint64(len(v)))
+		return append(b, v...)
+	case time.Duration:
+		return appendCBORInt(b, int64(v))
+	case ByteSize:
+		return appendCBORInt(b, int64(v))
+	case Count:
+		return appendCBORInt(b, int64(v))
+	case Field:
+		switch v.kind {
+		case stringKind:
+			return appendCBORText(b, v.str)
+		case intKind, durationKind:
+			return appendCBORInt(b, int64(v.num))
+		case uintKind:
+			return appendCBORHead(b, cborUint, v.num)
+		case floatKind:
+			return binary.BigEndian.AppendUint64(append(b, cborSimple|27), v.num)
+		case boolKind:
+			return appendCBOR(b, v.num == 1)
+		}
+		return appendCBOR(b, v.iface)
+	case time.Time:
+		return appendCBORTime(b, v)
+	case error:
+		return appendCBORText(b, v.Error())
+	case fmt.Stringer:
+		return appendCBORText(b, v.String())
+	case Fields:
+		return appendCBORMap(b, v)
+	case map[string]any:
+		return appendCBORMap(b, v)
+	case []any:
+		b = appendCBORHead(b, cborArray, uint64(len(v)))
+		for _, item := range v {
+			b = appendCBOR(b, item)
+		}
+		return b
+	case []string:
+		b = appendCBORHead(b, cborArray, uint64(len(v)))
+		for _, item := range v {
+			b = appendCBORText(b, item)
+		}
+		return b
+	}
+
+	var generic any
+	data, err := json.Marshal(v)
+	if err == nil && json.Unmarshal(data, &generic) == nil {
+		return appendCBOR(b, generic)
+	}
+	return appendCBORText(b, fmt.Sprint(v))
+}
+
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, major|25)
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	case n <= math.MaxUint32:
+		b = append(b, major|26)
+		return binary.BigEndian.AppendUint32(b, uint32(n))
+	default:
+		b = append(b, major|27)
+		return binary.BigEndian.AppendUint64(b, n)
+	}
+}
+
+func appendCBORInt(b []byte, v int64) []byte {
+	if v >= 0 {
+		return appendCBORHead(b, cborUint, uint64(v))
+	}
+	return appendCBORHead(b, cborNegInt, uint64(-(v + 1)))
+}
+
+func appendCBORText(b []byte, s string) []byte {
+	b = appendCBORHead(b, cborText, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendCBORTime(b []byte, t time.Time) []byte {
+	b = appendCBORHead(b, cborTag, 1)
+	return appendCBOR(b, float64(t.UnixNano())/1e9)
+}
+
+func appendCBORMap(b []byte, m map[string]any) []byte {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b = appendCBORHead(b, cborMap, uint64(len(keys)))
+	for _, k := range keys {
+		b = appendCBORText(b, k)
+		b = appendCBOR(b, m[k])
+	}
+	return b
+}
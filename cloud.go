@@ -0,0 +1,188 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	CloudAWS   = "aws"
+	CloudGCP   = "gcp"
+	CloudAzure = "azure"
+)
+
+var (
+	ec2MetadataURL   = "http://169.254.169.254/latest"
+	gceMetadataURL   = "http://metadata.google.internal/computeMetadata/v1"
+	azureMetadataURL = "http://169.254.169.254/metadata/instance/compute?api-version=2021-02-01"
+)
+
+var ErrNoCloudMetadata = errors.New("no cloud metadata endpoint available")
+
+type CloudMetadata struct {
+	Provider   string
+	InstanceID string
+	Region     string
+	Zone       string
+}
+
+func (m CloudMetadata) Fields() Fields {
+	fields := Fields{}
+	if m.Provider != "" {
+		fields["cloud_provider"] = m.Provider
+	}
+	if m.InstanceID != "" {
+		fields["instance_id"] = m.InstanceID
+	}
+	if m.Region != "" {
+		fields["region"] = m.Region
+	}
+	if m.Zone != "" {
+		fields["zone"] = m.Zone
+	}
+	return fields
+}
+
+func (m CloudMetadata) Enricher() Enricher {
+	fields := m.Fields()
+	return func(e *Entry) {
+		for k, v := range fields {
+			if _, ok := e.Fields[k]; !ok {
+				e.Fields[k] = v
+			}
+		}
+	}
+}
+
+func NewCloudMetadataEnricher(ctx context.Context) Enricher {
+	m, err := DetectCloudMetadata(ctx)
+	if err != nil {
+		return func(e *Entry) {}
+	}
+	return m.Enricher()
+}
+
+func DetectCloudMetadata(ctx context.Context) (CloudMetadata, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		metadata CloudMetadata
+		err      error
+	}
+	detectors := []func(context.Context, *http.Client) (CloudMetadata, error){
+		detectEC2,
+		detectGCE,
+		detectAzure,
+	}
+	client := &http.Client{Transport: &http.Transport{Proxy: nil}}
+	results := make(chan result, len(detectors))
+	for _, detect := range detectors {
+		go func(detect func(context.Context, *http.Client) (CloudMetadata, error)) {
+			m, err := detect(ctx, client)
+			results <- result{m, err}
+		}(detect)
+	}
+
+	for range detectors {
+		r := <-results
+		if r.err == nil {
+			return r.metadata, nil
+		}
+	}
+	return CloudMetadata{}, ErrNoCloudMetadata
+}
+
+func detectEC2(ctx context.Context, client *http.Client) (CloudMetadata, error) {
+	token, err := metadataRequest(ctx, client, http.MethodPut, ec2MetadataURL+"/api/token", map[string]string{
+		"X-aws-ec2-metadata-token-ttl-seconds": "60",
+	})
+	if err != nil {
+		return CloudMetadata{}, err
+	}
+	headers := map[string]string{"X-aws-ec2-metadata-token": token}
+
+	m := CloudMetadata{Provider: CloudAWS}
+	if m.InstanceID, err = metadataRequest(ctx, client, http.MethodGet, ec2MetadataURL+"/meta-data/instance-id", headers); err != nil {
+		return CloudMetadata{}, err
+	}
+	m.Region, _ = metadataRequest(ctx, client, http.MethodGet, ec2MetadataURL+"/meta-data/placement/region", headers)
+	m.Zone, _ = metadataRequest(ctx, client, http.MethodGet, ec2MetadataURL+"/meta-data/placement/availability-zone", headers)
+	return m, nil
+}
+
+func detectGCE(ctx context.Context, client *http.Client) (CloudMetadata, error) {
+	headers := map[string]string{"Metadata-Flavor": "Google"}
+
+	id, err := metadataRequest(ctx, client, http.MethodGet, gceMetadataURL+"/instance/id", headers)
+	if err != nil {
+		return CloudMetadata{}, err
+	}
+	m := CloudMetadata{Provider: CloudGCP, InstanceID: id}
+	if zone, err := metadataRequest(ctx, client, http.MethodGet, gceMetadataURL+"/instance/zone", headers); err == nil {
+		m.Zone = zone[strings.LastIndex(zone, "/")+1:]
+		if i := strings.LastIndex(m.Zone, "-"); i > 0 {
+			m.Region = m.Zone[:i]
+		}
+	}
+	return m, nil
+}
+
+func detectAzure(ctx context.Context, client *http.Client) (CloudMetadata, error) {
+	body, err := metadataRequest(ctx, client, http.MethodGet, azureMetadataURL, map[string]string{"Metadata": "true"})
+	if err != nil {
+		return CloudMetadata{}, err
+	}
+	var compute struct {
+		VMID     string `json:"vmId"`
+		Location string `json:"location"`
+		Zone     string `json:"zone"`
+	}
+	if err := json.Unmarshal([]byte(body), &compute); err != nil {
+		return CloudMetadata{}, err
+	}
+	return CloudMetadata{
+		Provider:   CloudAzure,
+		InstanceID: compute.VMID,
+		Region:     compute.Location,
+		Zone:       compute.Zone,
+	}, nil
+}
+
+func metadataRequest(ctx context.Context, client *http.Client, method string, url string, headers map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func(body io.ReadCloser) {
+		_ = body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata endpoint responded with status: %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gomessguii/logger"
+)
+
+type condition struct {
+	key   string
+	op    string
+	value string
+}
+
+type conditions []condition
+
+func (c *conditions) String() string {
+	parts := make([]string, 0, len(*c))
+	for _, cond := range *c {
+		parts = append(parts, cond.key+cond.op+cond.value)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (c *conditions) Set(expr string) error {
+	for i := 0; i < len(expr); i++ {
+		var op string
+		switch {
+		case strings.HasPrefix(expr[i:], "!="):
+			op = "!="
+		case expr[i] == '~' || expr[i] == '=':
+			op = expr[i : i+1]
+		default:
+			continue
+		}
+		if key := strings.TrimSpace(expr[:i]); key != "" {
+			*c = append(*c, condition{key: key, op: op, value: strings.TrimSpace(expr[i+len(op):])})
+			return nil
+		}
+		break
+	}
+	return fmt.Errorf("invalid expression %q, want key=value, key!=value or key~substring", expr)
+}
+
+type filter struct {
+	minLevel string
+	service  string
+	context  string
+	where    conditions
+}
+
+func (f filter) active() bool {
+	return f.minLevel != "" || f.service != "" || f.context != "" || len(f.where) > 0
+}
+
+func (f filter) match(entry logger.Entry) bool {
+	if f.minLevel != "" {
+		min, _ := levelRank(f.minLevel)
+		if rank, known := levelRank(entry.Level); known && rank < min {
+			return false
+		}
+	}
+	if f.service != "" && entry.Service != f.service {
+		return false
+	}
+	if f.context != "" && entry.Context != f.context {
+		return false
+	}
+	for _, cond := range f.where {
+		value, ok := lookup(entry, cond.key)
+		switch cond.op {
+		case "=":
+			if !ok || value != cond.value {
+				return false
+			}
+		case "!=":
+			if ok && value == cond.value {
+				return false
+			}
+		case "~":
+			if !ok || !strings.Contains(value, cond.value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func lookup(entry logger.Entry, key string) (string, bool) {
+	switch key {
+	case "level":
+		return entry.Level, true
+	case "service":
+		return entry.Service, true
+	case "context":
+		return entry.Context, true
+	case "message":
+		return entry.Message, true
+	}
+	v, ok := entry.Fields[key]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+var levels = []string{logger.TRACE, logger.DEBUG, logger.INFO, logger.WARN, logger.ERR, logger.FATAL}
+
+func levelRank(level string) (int, bool) {
+	for i, l := range levels {
+		if strings.EqualFold(l, level) {
+			return i, true
+		}
+	}
+	return len(levels), false
+}
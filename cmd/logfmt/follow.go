@@ -0,0 +1,32 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+const followPollInterval = 250 * time.Millisecond
+
+type followReader struct {
+	file  *os.File
+	moved int64
+}
+
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.file.Read(p)
+		r.moved += int64(n)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		if info, err := r.file.Stat(); err == nil && info.Size() < r.moved {
+			if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+				return 0, err
+			}
+			r.moved = 0
+			continue
+		}
+		time.Sleep(followPollInterval)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gomessguii/logger"
+)
+
+func main() {
+	format := flag.String("format", logger.FormatPretty, "output layout: pretty or text")
+	color := flag.String("color", logger.ColorAuto, "colorize output: auto, always or never")
+	follow := flag.Bool("f", false, "keep reading the file as it grows")
+	var f filter
+	flag.StringVar(&f.minLevel, "level", "", "only show entries at or above this level")
+	flag.StringVar(&f.service, "service", "", "only show entries from this service")
+	flag.StringVar(&f.context, "context", "", "only show entries with this context")
+	flag.Var(&f.where, "where", "field condition: key=value, key!=value or key~substring (repeatable)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: logfmt [flags] [file ...]\n\nReads JSON log lines from the given files (or stdin) and prints them for humans.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *format != logger.FormatPretty && *format != logger.FormatText {
+		fmt.Fprintf(os.Stderr, "logfmt: unknown format %q\n", *format)
+		os.Exit(2)
+	}
+	if _, known := levelRank(f.minLevel); f.minLevel != "" && !known {
+		fmt.Fprintf(os.Stderr, "logfmt: unknown level %q\n", f.minLevel)
+		os.Exit(2)
+	}
+	if *follow && flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "logfmt: -f requires exactly one file")
+		os.Exit(2)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	l := &logger.Logger{Format: *format, ColorMode: *color}
+	encoder := l.ConsoleEncoder(os.Stdout)
+
+	if flag.NArg() == 0 {
+		if err := render(os.Stdin, out, encoder, f); err != nil {
+			fail(out, err)
+		}
+		return
+	}
+	for _, name := range flag.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			fail(out, err)
+		}
+		var r io.Reader = file
+		if *follow {
+			r = &followReader{file: file}
+		}
+		err = render(r, out, encoder, f)
+		_ = file.Close()
+		if err != nil {
+			fail(out, err)
+		}
+	}
+}
+
+func render(r io.Reader, w *bufio.Writer, encoder logger.Encoder, f filter) error {
+	scanner := bufio.NewScanner(flushingReader{r: r, w: w})
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		entry, err := logger.ParseJSONEntry(line)
+		if err != nil {
+			if !f.active() {
+				_, _ = w.Write(line)
+				_ = w.WriteByte('\n')
+			}
+			continue
+		}
+		if !f.match(entry) {
+			continue
+		}
+		b, err := encoder.Encode(entry)
+		if err != nil {
+			return err
+		}
+		_, _ = w.Write(b)
+	}
+	return scanner.Err()
+}
+
+type flushingReader struct {
+	r io.Reader
+	w *bufio.Writer
+}
+
+func (f flushingReader) Read(p []byte) (int, error) {
+	if err := f.w.Flush(); err != nil {
+		return 0, err
+	}
+	return f.r.Read(p)
+}
+
+func fail(out *bufio.Writer, err error) {
+	_ = out.Flush()
+	fmt.Fprintf(os.Stderr, "logfmt: %v\n", err)
+	os.Exit(1)
+}
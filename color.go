@@ -0,0 +1,111 @@
+package logger
+
+import (
+	"io"
+	"os"
+	"sync"
+)
+
+const (
+	colorReset = "\033[0m"
+	colorDim   = "\033[2m"
+)
+
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
+
+type ColorScheme struct {
+	Info    string
+	Warn    string
+	Err     string
+	Debug   string
+	Service string
+}
+
+var (
+	DefaultColorScheme = ColorScheme{
+		Info:    "\033[44m",
+		Warn:    "\033[43m",
+		Err:     "\033[41m",
+		Debug:   "\033[40m\033[37m",
+		Service: "\033[35m",
+	}
+	HighContrastColorScheme = ColorScheme{
+		Info:    "\033[1;97;44m",
+		Warn:    "\033[1;30;103m",
+		Err:     "\033[1;97;101m",
+		Debug:   "\033[1;97;40m",
+		Service: "\033[1;95m",
+	}
+	MonochromeColorScheme = ColorScheme{}
+)
+
+var terminalCache sync.Map
+
+func (l *Logger) colorScheme(w io.Writer) ColorScheme {
+	if !l.colorsEnabled(w) {
+		return MonochromeColorScheme
+	}
+	if l.Colors != nil {
+		return *l.Colors
+	}
+	return DefaultColorScheme
+}
+
+func (c ColorScheme) levelColor(logLevel string) string {
+	switch logLevel {
+	case ERR, FATAL:
+		return c.Err
+	case WARN:
+		return c.Warn
+	case TRACE, DEBUG:
+		return c.Debug
+	default:
+		return c.Info
+	}
+}
+
+func colorize(color string, text string) string {
+	if color == "" {
+		return text
+	}
+	return color + text + colorReset
+}
+
+func (l *Logger) colorsEnabled(w io.Writer) bool {
+	switch l.ColorMode {
+	case ColorAlways:
+		if f, ok := w.(*os.File); ok {
+			enableVirtualTerminal(f)
+		}
+		return true
+	case ColorNever:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if force := os.Getenv("FORCE_COLOR"); force != "" {
+		return force != "0" && force != "false"
+	}
+	return isTerminal(w)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	if cached, ok := terminalCache.Load(f); ok {
+		return cached.(bool)
+	}
+	terminal := false
+	if info, err := f.Stat(); err == nil {
+		terminal = info.Mode()&os.ModeCharDevice != 0 && enableVirtualTerminal(f)
+	}
+	terminalCache.Store(f, terminal)
+	return terminal
+}
@@ -0,0 +1,42 @@
+//go:build windows
+
+package logger
+
+import (
+	"os"
+	"sync"
+	"syscall"
+)
+
+const enableVirtualTerminalProcessing = 0x0004
+
+var (
+	kernel32           = syscall.NewLazyDLL("kernel32.dll")
+	procSetConsoleMode = kernel32.NewProc("SetConsoleMode")
+
+	virtualTerminalCache sync.Map
+)
+
+func enableVirtualTerminal(f *os.File) bool {
+	if cached, ok := virtualTerminalCache.Load(f); ok {
+		return cached.(bool)
+	}
+	enabled := setVirtualTerminalMode(syscall.Handle(f.Fd()))
+	virtualTerminalCache.Store(f, enabled)
+	return enabled
+}
+
+func setVirtualTerminalMode(handle syscall.Handle) bool {
+	var mode uint32
+	if err := syscall.GetConsoleMode(handle, &mode); err != nil {
+		return false
+	}
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return true
+	}
+	if err := procSetConsoleMode.Find(); err != nil {
+		return false
+	}
+	ret, _, _ := procSetConsoleMode.Call(uintptr(handle), uintptr(mode|enableVirtualTerminalProcessing))
+	return ret != 0
+}
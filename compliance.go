@@ -0,0 +1,55 @@
+package logger
+
+import "strings"
+
+const (
+	ComplianceReject = "reject"
+	ComplianceFlag   = "flag"
+)
+
+const ComplianceMissingField = "compliance_missing"
+
+var DefaultRequiredFields = []string{"trace_id", "actor"}
+
+type ComplianceConfig struct {
+	RequiredFields []string
+	Mode           string
+}
+
+func (c *ComplianceConfig) missing(entry Entry) []string {
+	var missing []string
+	if entry.Time.IsZero() {
+		missing = append(missing, "time")
+	}
+	if entry.Level == "" {
+		missing = append(missing, "level")
+	}
+	if entry.Service == "" {
+		missing = append(missing, "service")
+	}
+	required := c.RequiredFields
+	if required == nil {
+		required = DefaultRequiredFields
+	}
+	for _, field := range required {
+		if v, ok := entry.Fields[field]; !ok || fieldValue(v) == nil || fieldValue(v) == "" {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
+
+func (l *Logger) checkCompliance(entry Entry) (Entry, bool) {
+	if l.Compliance == nil {
+		return entry, true
+	}
+	missing := l.Compliance.missing(entry)
+	if len(missing) == 0 {
+		return entry, true
+	}
+	if l.Compliance.Mode == ComplianceFlag {
+		return entry.WithField(ComplianceMissingField, missing), true
+	}
+	l.logInternal("Rejected non-compliant entry %q: missing %s\n", strings.TrimSpace(entry.Message), strings.Join(missing, ", "))
+	return entry, false
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+const maxGoroutineDumpSize = 64 << 20
+
+type CrashReportConfig struct {
+	Dir    string
+	Recent *RingBuffer
+}
+
+func (l *Logger) crashes(entry Entry) bool {
+	if l.CrashReport == nil {
+		return false
+	}
+	_, panicked := entry.Fields[PanicField]
+	return entry.Level == FATAL || panicked
+}
+
+func (l *Logger) writeCrashReport(entry Entry) {
+	dir := l.CrashReport.Dir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		l.handleError(fmt.Errorf("failed to create crash report directory: %w", err))
+		return
+	}
+	name := fmt.Sprintf("crash-%s-%d.txt", entry.Time.UTC().Format("20060102T150405.000000000Z"), os.Getpid())
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, l.crashReport(entry), 0o600); err != nil {
+		l.handleError(fmt.Errorf("failed to write crash report: %w", err))
+	}
+}
+
+func (l *Logger) crashReport(entry Entry) []byte {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Time: %s\n", entry.Time.Format(time.RFC3339Nano))
+	fmt.Fprintf(&sb, "Service: %s\n", entry.Service)
+	if entry.Context != "" {
+		fmt.Fprintf(&sb, "Context: %s\n", entry.Context)
+	}
+	fmt.Fprintf(&sb, "Level: %s\n", entry.Level)
+	fmt.Fprintf(&sb, "Host: %s\n", hostname())
+	fmt.Fprintf(&sb, "PID: %d\n", os.Getpid())
+	fmt.Fprintf(&sb, "Message: %s\n", strings.TrimRight(entry.Message, "\n"))
+	if len(entry.Fields) > 0 {
+		fmt.Fprintf(&sb, "Fields: %s\n", formatFields(entry.Fields))
+	}
+	if len(entry.Stack) > 0 {
+		sb.WriteString("\n== Stack ==\n")
+		sb.WriteString(formatStack(entry.Stack))
+		sb.WriteByte('\n')
+	}
+
+	sb.WriteString("\n== Goroutines ==\n")
+	dump, _ := goroutineDump(maxGoroutineDumpSize)
+	sb.Write(dump)
+
+	sb.WriteString("\n== Build ==\n")
+	fmt.Fprintf(&sb, "go %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	if info, ok := debug.ReadBuildInfo(); ok {
+		sb.WriteString(info.String())
+	}
+
+	if l.CrashReport.Recent != nil {
+		sb.WriteString("\n== Recent entries ==\n")
+		encoder := TextEncoder{Colors: MonochromeColorScheme}
+		for _, recent := range l.CrashReport.Recent.Entries() {
+			if b, err := encoder.Encode(recent); err == nil {
+				sb.Write(b)
+			}
+		}
+	}
+	return []byte(sb.String())
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"sync"
+)
+
+var (
+	contextKeysMu sync.RWMutex
+	contextKeys   = map[any]string{}
+)
+
+func RegisterContextKey(key any, field string) {
+	contextKeysMu.Lock()
+	defer contextKeysMu.Unlock()
+	contextKeys[key] = field
+}
+
+func UnregisterContextKey(key any) {
+	contextKeysMu.Lock()
+	defer contextKeysMu.Unlock()
+	delete(contextKeys, key)
+}
+
+func ContextFields(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+	contextKeysMu.RLock()
+	defer contextKeysMu.RUnlock()
+	var fields Fields
+	for key, field := range contextKeys {
+		v := ctx.Value(key)
+		if v == nil {
+			continue
+		}
+		if fields == nil {
+			fields = make(Fields, len(contextKeys))
+		}
+		fields[field] = v
+	}
+	return fields
+}
+
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	fields := ContextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.withRootFields(fields)
+}
+
+func (l *Logger) LogCtx(ctx context.Context, logLevel string, format string, v ...any) {
+	l.WithContext(ctx).Log(logLevel, format, v...)
+}
+
+func (l *Logger) LogInfoCtx(ctx context.Context, format string, v ...any) {
+	l.WithContext(ctx).LogInfo(format, v...)
+}
+
+func (l *Logger) LogErrorCtx(ctx context.Context, format string, v ...any) {
+	l.WithContext(ctx).LogError(format, v...)
+}
+
+func (l *Logger) LogFatalCtx(ctx context.Context, format string, v ...any) {
+	l.WithContext(ctx).emit(FATAL, format, v, l.WebhookConfig.sends(FATAL), true)
+	l.Flush()
+	os.Exit(1)
+}
+
+func (l *Logger) LogWarnCtx(ctx context.Context, format string, v ...any) {
+	l.WithContext(ctx).LogWarn(format, v...)
+}
+
+func (l *Logger) LogDebugCtx(ctx context.Context, format string, v ...any) {
+	l.WithContext(ctx).LogDebug(format, v...)
+}
+
+func (l *Logger) LogwCtx(ctx context.Context, logLevel string, msg string, keysAndValues ...any) {
+	l.WithContext(ctx).Logw(logLevel, msg, keysAndValues...)
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const datadogMaxBatchSize = 1000
+
+type DatadogSink struct {
+	APIKey         string
+	Site           string
+	Source         string
+	Tags           []string
+	Service        string
+	BatchSize      int
+	FlushInterval  time.Duration
+	Compress       bool
+	HTTPClient     *http.Client
+	ErrorHandler   func(err error)
+	OverflowPolicy string
+
+	once    sync.Once
+	batcher *entryBatcher
+}
+
+func (d *DatadogSink) Write(entry Entry) error {
+	return d.batch().add(entry)
+}
+
+func (d *DatadogSink) Close() error {
+	return d.batch().close()
+}
+
+func (d *DatadogSink) Dropped() uint64 {
+	return d.batch().dropped.Load()
+}
+
+func (d *DatadogSink) batch() *entryBatcher {
+	d.once.Do(func() {
+		d.batcher = newEntryBatcher(min(d.BatchSize, datadogMaxBatchSize), d.FlushInterval, d.OverflowPolicy, d.send, d.ErrorHandler)
+	})
+	return d.batcher
+}
+
+func (d *DatadogSink) send(entries []Entry) error {
+	source := d.Source
+	if source == "" {
+		source = "go"
+	}
+	tags := strings.Join(d.Tags, ",")
+
+	logs := make([]map[string]any, 0, len(entries))
+	for _, entry := range entries {
+		item := jsonFields(entry.Fields)
+		if item == nil {
+			item = map[string]any{}
+		}
+		service := d.Service
+		if service == "" {
+			service = entry.Service
+		}
+		item["ddsource"] = source
+		item["service"] = service
+		item["hostname"] = hostname()
+		item["message"] = strings.TrimRight(entry.Message, "\n")
+		item["status"] = SeverityName(entry.Level)
+		item["timestamp"] = entry.Time.UnixMilli()
+		if tags != "" {
+			item["ddtags"] = tags
+		}
+		if entry.Context != "" {
+			item["logger.name"] = entry.Context
+		}
+		logs = append(logs, item)
+	}
+
+	body, err := json.Marshal(logs)
+	if err != nil {
+		return err
+	}
+
+	site := d.Site
+	if site == "" {
+		site = "datadoghq.com"
+	}
+	headers := map[string]string{"DD-API-KEY": d.APIKey}
+	return postBody(context.Background(), d.HTTPClient, "https://http-intake.logs."+site+"/api/v2/logs", "application/json", headers, body, d.Compress)
+}
@@ -0,0 +1,148 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxWebhookDigestGroups = 1000
+
+var digitsPattern = regexp.MustCompile(`[0-9]+`)
+
+type DigestGroup struct {
+	Level     string    `json:"level"`
+	Signature string    `json:"signature"`
+	Message   string    `json:"message"`
+	Count     int       `json:"count"`
+	First     time.Time `json:"first"`
+	Last      time.Time `json:"last"`
+}
+
+type webhookDigest struct {
+	logger   *Logger
+	mu       sync.Mutex
+	started  time.Time
+	groups   map[string]*DigestGroup
+	overflow DigestGroup
+	timer    *time.Timer
+}
+
+func (l *Logger) webhookDigest() *webhookDigest {
+	state := l.shared()
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if state.digest == nil {
+		state.digest = &webhookDigest{logger: l}
+	}
+	return state.digest
+}
+
+func (l *Logger) currentDigest() *webhookDigest {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if l.state == nil {
+		return nil
+	}
+	return l.state.digest
+}
+
+func messageSignature(logLevel string, message string) string {
+	message = strings.TrimSpace(message)
+	return logLevel + ":" + digitsPattern.ReplaceAllString(message, "#")
+}
+
+func (d *webhookDigest) add(entry Entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.groups == nil {
+		d.groups = make(map[string]*DigestGroup)
+		d.started = entry.Time
+		d.timer = time.AfterFunc(d.logger.WebhookConfig.DigestWindow, d.flush)
+	}
+
+	signature := messageSignature(entry.Level, entry.Message)
+	group, ok := d.groups[signature]
+	if !ok && len(d.groups) >= maxWebhookDigestGroups {
+		if d.overflow.Count == 0 || levelRank(entry.Level) > levelRank(d.overflow.Level) {
+			d.overflow.Level = entry.Level
+		}
+		d.overflow.Count++
+		return
+	}
+	if !ok {
+		group = &DigestGroup{
+			Level:     entry.Level,
+			Signature: signature,
+			Message:   strings.TrimSpace(entry.Message),
+			First:     entry.Time,
+		}
+		d.groups[signature] = group
+	}
+	group.Count++
+	group.Last = entry.Time
+}
+
+func (d *webhookDigest) flush() {
+	d.mu.Lock()
+	groups, overflow := d.groups, d.overflow
+	started := d.started
+	d.groups = nil
+	d.overflow = DigestGroup{}
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+	d.mu.Unlock()
+
+	if len(groups) == 0 {
+		return
+	}
+	d.logger.deliverWebhook(d.logger.digestEntry(started, groups, overflow))
+}
+
+func (l *Logger) digestEntry(started time.Time, groups map[string]*DigestGroup, overflow DigestGroup) Entry {
+	sorted := make([]DigestGroup, 0, len(groups))
+	total := overflow.Count
+	level := WARN
+	if overflow.Count > 0 && levelRank(overflow.Level) > levelRank(level) {
+		level = overflow.Level
+	}
+	for _, group := range groups {
+		sorted = append(sorted, *group)
+		total += group.Count
+		if levelRank(group.Level) > levelRank(level) {
+			level = group.Level
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Signature < sorted[j].Signature
+	})
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d warnings/errors since %s", total, started.Format(time.RFC3339))
+	for _, group := range sorted {
+		fmt.Fprintf(&sb, "\n%dx [%s] %s", group.Count, group.Level, group.Message)
+	}
+	if overflow.Count > 0 {
+		fmt.Fprintf(&sb, "\n%dx in groups beyond the %d tracked", overflow.Count, maxWebhookDigestGroups)
+	}
+
+	entry := l.newEntry(level, sb.String())
+	if entry.Fields == nil {
+		entry.Fields = Fields{}
+	}
+	entry.Fields["digest_total"] = total
+	entry.Fields["digest_groups"] = len(sorted)
+	if overflow.Count > 0 {
+		entry.Fields["digest_overflow"] = overflow.Count
+	}
+	return entry
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	discordMaxTitle       = 256
+	discordMaxAuthor      = 256
+	discordMaxUsername    = 80
+	discordMaxDescription = 4096
+	discordMaxFields      = 25
+	discordMaxFieldName   = 256
+	discordMaxFieldValue  = 1024
+	discordMaxEmbed       = 6000
+)
+
+type DiscordPayload struct{}
+
+func (DiscordPayload) Build(entry Entry) (string, []byte, error) {
+	color, _ := strconv.ParseInt(strings.TrimPrefix(levelHexColor(entry.Level), "#"), 16, 32)
+
+	title := "[" + levelLabel(entry.Level) + "]"
+	if entry.Context != "" {
+		title += " " + entry.Context
+	}
+	title = truncateText(title, discordMaxTitle)
+	author := truncateText(entry.Service, discordMaxAuthor)
+	budget := discordMaxEmbed - utf8.RuneCountInString(title) - utf8.RuneCountInString(author)
+	description := truncateText(entry.Message, min(discordMaxDescription, budget))
+	budget -= utf8.RuneCountInString(description)
+
+	type embedField struct {
+		Name   string `json:"name"`
+		Value  string `json:"value"`
+		Inline bool   `json:"inline"`
+	}
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fields := make([]embedField, 0, min(len(keys), discordMaxFields))
+	for _, k := range keys {
+		name := truncateText(k, discordMaxFieldName)
+		room := budget - utf8.RuneCountInString(name)
+		if len(fields) == discordMaxFields || room < 2 {
+			break
+		}
+		value := strings.TrimSpace(fmt.Sprint(entry.Fields[k]))
+		if value == "" {
+			value = "—"
+		}
+		value = truncateText(value, min(discordMaxFieldValue, room))
+		budget = room - utf8.RuneCountInString(value)
+		fields = append(fields, embedField{Name: name, Value: value, Inline: true})
+	}
+
+	payload := map[string]any{
+		"username": truncateText(entry.Service, discordMaxUsername),
+		"embeds": []map[string]any{{
+			"title":       title,
+			"description": description,
+			"color":       color,
+			"author":      map[string]string{"name": author},
+			"timestamp":   entry.Time.Format(time.RFC3339),
+			"fields":      fields,
+		}},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	return "application/json", body, nil
+}
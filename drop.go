@@ -0,0 +1,31 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	DropSampled    = "sampled"
+	DropFiltered   = "filtered"
+	DropCompliance = "compliance"
+	DropQueueFull  = "queue_full"
+)
+
+func (l *Logger) drop(entry Entry, reason string) {
+	if l.OnDrop != nil {
+		l.OnDrop(entry, reason)
+	}
+}
+
+func isQueueFull(err error) bool {
+	return errors.Is(err, ErrQueueFull) || errors.Is(err, ErrSinkBackpressure)
+}
+
+type evictedError struct {
+	entries []Entry
+}
+
+func (e *evictedError) Error() string {
+	return fmt.Sprintf("queue is full, evicted %d oldest entries", len(e.entries))
+}
@@ -0,0 +1,229 @@
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type DumpConfig struct {
+	MaxDepth        int
+	MaxItems        int
+	MaxStringLength int
+	MaxHexBytes     int
+}
+
+func (l *Logger) LogDump(label string, v any) {
+	if !l.enabled(DEBUG) {
+		return
+	}
+	l.Log(DEBUG, "%s: %s", label, Dump(v, l.DumpConfig))
+}
+
+func Dump(v any, config DumpConfig) string {
+	if config.MaxDepth <= 0 {
+		config.MaxDepth = 5
+	}
+	if config.MaxItems <= 0 {
+		config.MaxItems = 50
+	}
+	if config.MaxStringLength <= 0 {
+		config.MaxStringLength = 256
+	}
+	d := dumper{config: config, visited: map[uintptr]bool{}}
+	d.dump(reflect.ValueOf(v), 0)
+	return d.sb.String()
+}
+
+type dumper struct {
+	config  DumpConfig
+	visited map[uintptr]bool
+	sb      strings.Builder
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+func (d *dumper) dump(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.sb.WriteString("nil")
+		return
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			d.sb.WriteString("nil")
+			return
+		}
+		d.dump(v.Elem(), depth)
+		return
+	}
+	if v.Kind() != reflect.Struct && v.Type().Implements(stringerType) && v.CanInterface() {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			d.sb.WriteString("nil")
+			return
+		}
+		d.writeString(v.Interface().(fmt.Stringer).String())
+		return
+	}
+	if v.Kind() == reflect.Struct && v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			d.writeString(s.String())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			d.sb.WriteString("nil")
+			return
+		}
+		if d.enter(v.Pointer()) {
+			return
+		}
+		defer delete(d.visited, v.Pointer())
+		d.sb.WriteByte('&')
+		d.dump(v.Elem(), depth)
+	case reflect.Struct:
+		d.sb.WriteString(v.Type().String())
+		if depth >= d.config.MaxDepth {
+			d.sb.WriteString("{...}")
+			return
+		}
+		d.sb.WriteString("{\n")
+		for i := 0; i < v.NumField(); i++ {
+			d.indent(depth + 1)
+			d.sb.WriteString(v.Type().Field(i).Name)
+			d.sb.WriteString(": ")
+			d.dump(v.Field(i), depth+1)
+			d.sb.WriteString(",\n")
+		}
+		d.indent(depth)
+		d.sb.WriteByte('}')
+	case reflect.Map:
+		if v.IsNil() {
+			d.sb.WriteString("nil")
+			return
+		}
+		if d.enter(v.Pointer()) {
+			return
+		}
+		defer delete(d.visited, v.Pointer())
+		d.sb.WriteString(v.Type().String())
+		if depth >= d.config.MaxDepth {
+			d.sb.WriteString("{...}")
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		d.sb.WriteString("{\n")
+		for i, key := range keys {
+			if i >= d.config.MaxItems {
+				d.indent(depth + 1)
+				fmt.Fprintf(&d.sb, "... (%d more)\n", len(keys)-i)
+				break
+			}
+			d.indent(depth + 1)
+			d.dump(key, depth+1)
+			d.sb.WriteString(": ")
+			d.dump(v.MapIndex(key), depth+1)
+			d.sb.WriteString(",\n")
+		}
+		d.indent(depth)
+		d.sb.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice {
+			if v.IsNil() {
+				d.sb.WriteString("nil")
+				return
+			}
+			if d.enter(v.Pointer()) {
+				return
+			}
+			defer delete(d.visited, v.Pointer())
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			d.dumpBytes(v)
+			return
+		}
+		d.sb.WriteString(v.Type().String())
+		if depth >= d.config.MaxDepth {
+			d.sb.WriteString("{...}")
+			return
+		}
+		if v.Len() == 0 {
+			d.sb.WriteString("{}")
+			return
+		}
+		d.sb.WriteString("{\n")
+		for i := 0; i < v.Len(); i++ {
+			if i >= d.config.MaxItems {
+				d.indent(depth + 1)
+				fmt.Fprintf(&d.sb, "... (%d more)\n", v.Len()-i)
+				break
+			}
+			d.indent(depth + 1)
+			d.dump(v.Index(i), depth+1)
+			d.sb.WriteString(",\n")
+		}
+		d.indent(depth)
+		d.sb.WriteByte('}')
+	case reflect.String:
+		d.writeString(v.String())
+	case reflect.Bool:
+		d.sb.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.sb.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d.sb.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		d.sb.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		d.sb.WriteString(strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			d.sb.WriteString("nil")
+			return
+		}
+		fmt.Fprintf(&d.sb, "%s(0x%x)", v.Type().String(), v.Pointer())
+	default:
+		d.sb.WriteString(v.Type().String())
+	}
+}
+
+func (d *dumper) enter(ptr uintptr) bool {
+	if d.visited[ptr] {
+		d.sb.WriteString("<cycle>")
+		return true
+	}
+	d.visited[ptr] = true
+	return false
+}
+
+func (d *dumper) dumpBytes(v reflect.Value) {
+	n := min(v.Len(), d.config.MaxItems)
+	b := make([]byte, n)
+	for i := range n {
+		b[i] = byte(v.Index(i).Uint())
+	}
+	fmt.Fprintf(&d.sb, "%s(%d) %x", v.Type().String(), v.Len(), b)
+	if v.Len() > n {
+		d.sb.WriteString("...")
+	}
+}
+
+func (d *dumper) writeString(s string) {
+	if len(s) > d.config.MaxStringLength {
+		d.sb.WriteString(strconv.Quote(s[:d.config.MaxStringLength]))
+		fmt.Fprintf(&d.sb, "... (%d bytes)", len(s))
+		return
+	}
+	d.sb.WriteString(strconv.Quote(s))
+}
+
+func (d *dumper) indent(depth int) {
+	d.sb.WriteString(strings.Repeat("  ", depth))
+}
@@ -0,0 +1,234 @@
+package logger
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEmailTimeout   = 30 * time.Second
+	maxEmailDigestEntries = 100
+)
+
+type EmailNotifier struct {
+	Host           string
+	Port           int
+	Username       string
+	Password       string
+	From           string
+	To             []string
+	SubjectPrefix  string
+	DigestErrors   bool
+	DigestInterval time.Duration
+	Timeout        time.Duration
+
+	mu       sync.Mutex
+	digest   []Entry
+	overflow int
+	last     time.Time
+	timer    *time.Timer
+}
+
+func (n *EmailNotifier) Levels() []string {
+	if n.DigestErrors {
+		return []string{ERR, FATAL}
+	}
+	return []string{FATAL}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, entry Entry) error {
+	if entry.Level != FATAL {
+		n.addToDigest(entry)
+		return nil
+	}
+
+	subject := fmt.Sprintf("[%s] %s: %s", entry.Level, entry.Service, firstLine(entry.Message))
+	return n.send(ctx, subject, formatEmailEntry(entry))
+}
+
+func (n *EmailNotifier) FlushDigest() error {
+	return n.FlushDigestContext(context.Background())
+}
+
+func (n *EmailNotifier) FlushDigestContext(ctx context.Context) error {
+	n.mu.Lock()
+	entries, overflow, last := n.digest, n.overflow, n.last
+	n.digest, n.overflow = nil, 0
+	if n.timer != nil {
+		n.timer.Stop()
+		n.timer = nil
+	}
+	n.mu.Unlock()
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	total := len(entries) + overflow
+	var body strings.Builder
+	fmt.Fprintf(&body, "%d errors were logged between %s and %s.\n\n",
+		total, entries[0].Time.Format(time.RFC1123), last.Format(time.RFC1123))
+	for _, entry := range entries {
+		body.WriteString(formatEmailEntry(entry))
+		body.WriteString("\n")
+	}
+	if overflow > 0 {
+		fmt.Fprintf(&body, "...and %d more errors not included in this digest.\n", overflow)
+	}
+	subject := fmt.Sprintf("[ERR digest] %s: %d errors", entries[0].Service, total)
+	return n.send(ctx, subject, body.String())
+}
+
+func (n *EmailNotifier) addToDigest(entry Entry) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.last = entry.Time
+	if len(n.digest) < maxEmailDigestEntries {
+		entry.Stack = nil
+		n.digest = append(n.digest, entry)
+	} else {
+		n.overflow++
+	}
+	if n.timer == nil {
+		interval := n.DigestInterval
+		if interval <= 0 {
+			interval = time.Hour
+		}
+		n.timer = time.AfterFunc(interval, func() {
+			if err := n.FlushDigest(); err != nil {
+				log.Printf("Failed to send email digest: %v", err)
+			}
+		})
+	}
+}
+
+func (n *EmailNotifier) send(ctx context.Context, subject string, body string) error {
+	port := n.Port
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(n.Host, strconv.Itoa(port))
+
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(n.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", sanitizeHeader(n.SubjectPrefix+subject)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return n.sendMail(ctx, addr, auth, []byte(msg.String()))
+}
+
+func (n *EmailNotifier) sendMail(ctx context.Context, addr string, auth smtp.Auth, msg []byte) error {
+	timeout := n.Timeout
+	if timeout <= 0 {
+		timeout = defaultEmailTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	c, err := smtp.NewClient(conn, n.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.Host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+	if err := c.Mail(n.From); err != nil {
+		return err
+	}
+	for _, to := range n.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func formatEmailEntry(entry Entry) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Time:    %s\n", entry.Time.Format(time.RFC3339))
+	fmt.Fprintf(&sb, "Level:   %s\n", entry.Level)
+	fmt.Fprintf(&sb, "Service: %s\n", entry.Service)
+	if entry.Context != "" {
+		fmt.Fprintf(&sb, "Context: %s\n", entry.Context)
+	}
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%s: %v\n", k, entry.Fields[k])
+	}
+	fmt.Fprintf(&sb, "\n%s\n", strings.TrimRight(entry.Message, "\n"))
+	return sb.String()
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return truncateText(s, 120)
+}
+
+func sanitizeHeader(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"io"
+	"strings"
+	"sync"
+)
+
+const textTimeFormat = "2006/01/02 15:04:05"
+
+type Encoder interface {
+	Encode(entry Entry) ([]byte, error)
+}
+
+type TextEncoder struct {
+	Colors ColorScheme
+	Stack  StackFormat
+	Levels LevelFormat
+}
+
+func (e TextEncoder) Encode(entry Entry) ([]byte, error) {
+	return []byte(entry.Time.Format(textTimeFormat) + " " + strings.TrimSuffix(formatText(entry, e.Colors, e.Stack, e.Levels), "\n") + "\n"), nil
+}
+
+type JSONEncoder struct {
+	Stack StackFormat
+}
+
+func (e JSONEncoder) Encode(entry Entry) ([]byte, error) {
+	return encodeJSONStack(entry, e.Stack), nil
+}
+
+type PrettyEncoder struct {
+	Colors ColorScheme
+	Stack  StackFormat
+	Levels LevelFormat
+}
+
+func (e PrettyEncoder) Encode(entry Entry) ([]byte, error) {
+	return encodePretty(entry, e.Colors, e.Colors != MonochromeColorScheme, e.Stack, e.Levels), nil
+}
+
+type WriterSink struct {
+	Writer  io.Writer
+	Encoder Encoder
+
+	mu sync.Mutex
+}
+
+func (s *WriterSink) Write(entry Entry) error {
+	encoder := s.Encoder
+	if encoder == nil {
+		encoder = JSONEncoder{}
+	}
+	b, err := encoder.Encode(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.Writer.Write(b)
+	return err
+}
+
+func (s *WriterSink) Close() error {
+	return nil
+}
@@ -0,0 +1,81 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	ErrorChainField    = "error_chain"
+	ErrorRootField     = "error_root"
+	ErrorRootTypeField = "error_root_type"
+)
+
+type StackTracer interface {
+	StackFrames() []Frame
+}
+
+type ErrorLink struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+func (e ErrorLink) String() string {
+	return e.Type + ": " + e.Message
+}
+
+func ErrorChain(err error) []ErrorLink {
+	var chain []ErrorLink
+	walkErrors(err, func(err error) {
+		chain = append(chain, ErrorLink{Message: err.Error(), Type: fmt.Sprintf("%T", err)})
+	})
+	return chain
+}
+
+func RootCause(err error) error {
+	for {
+		var next error
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range joined.Unwrap() {
+				if inner != nil {
+					next = inner
+					break
+				}
+			}
+		} else {
+			next = errors.Unwrap(err)
+		}
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+func walkErrors(err error, visit func(error)) {
+	for err != nil {
+		visit(err)
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range joined.Unwrap() {
+				walkErrors(inner, visit)
+			}
+			return
+		}
+		err = errors.Unwrap(err)
+	}
+}
+
+func expandError(entry Entry) Entry {
+	root := RootCause(entry.Err)
+	entry = entry.WithField(ErrorChainField, ErrorChain(entry.Err))
+	entry.Fields[ErrorRootField] = root.Error()
+	entry.Fields[ErrorRootTypeField] = fmt.Sprintf("%T", root)
+
+	if entry.Stack == nil {
+		var tracer StackTracer
+		if errors.As(entry.Err, &tracer) {
+			entry.Stack = tracer.StackFrames()
+		}
+	}
+	return entry
+}
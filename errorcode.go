@@ -0,0 +1,56 @@
+package logger
+
+import "sync"
+
+const (
+	ErrorCodeField        = "error_code"
+	ErrorDescriptionField = "error_description"
+)
+
+var (
+	errorCatalogMu sync.RWMutex
+	errorCatalog   = map[string]string{}
+)
+
+func RegisterErrorCode(code string, description string) {
+	errorCatalogMu.Lock()
+	defer errorCatalogMu.Unlock()
+	errorCatalog[code] = description
+}
+
+func RegisterErrorCodes(codes map[string]string) {
+	errorCatalogMu.Lock()
+	defer errorCatalogMu.Unlock()
+	for code, description := range codes {
+		errorCatalog[code] = description
+	}
+}
+
+func ErrorCodeDescription(code string) (string, bool) {
+	errorCatalogMu.RLock()
+	defer errorCatalogMu.RUnlock()
+	description, ok := errorCatalog[code]
+	return description, ok
+}
+
+func (l *Logger) WithCode(code string) *Logger {
+	fields := Fields{ErrorCodeField: code}
+	if description, ok := ErrorCodeDescription(code); ok {
+		fields[ErrorDescriptionField] = description
+	}
+	return l.withRootFields(fields)
+}
+
+func (e Entry) Code() string {
+	code, _ := fieldValue(e.Fields[ErrorCodeField]).(string)
+	return code
+}
+
+func (c WebhookConfig) codeUrls(entry Entry) ([]string, bool) {
+	code := entry.Code()
+	if code == "" {
+		return nil, false
+	}
+	urls, ok := c.CodeUrls[code]
+	return urls, ok
+}
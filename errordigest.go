@@ -0,0 +1,174 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxKnownErrorSignatures = 10000
+	maxErrorDigestGroups    = 1000
+)
+
+type ErrorDigestConfig struct {
+	Interval time.Duration
+	TopN     int
+	Level    string
+}
+
+type errorDigest struct {
+	config   ErrorDigestConfig
+	mu       sync.Mutex
+	groups   map[string]*DigestGroup
+	overflow int
+	known    map[string]bool
+	since    time.Time
+}
+
+func (l *Logger) StartErrorDigest(ctx context.Context, config ErrorDigestConfig) {
+	if config.Interval <= 0 {
+		config.Interval = time.Hour
+	}
+	if config.TopN <= 0 {
+		config.TopN = 10
+	}
+	if config.Level == "" {
+		config.Level = ERR
+	}
+
+	d := &errorDigest{config: config, groups: map[string]*DigestGroup{}, known: map[string]bool{}, since: l.now()}
+	state := l.shared()
+	stateMu.Lock()
+	state.errorDigest = d
+	stateMu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				stateMu.Lock()
+				if state.errorDigest == d {
+					state.errorDigest = nil
+				}
+				stateMu.Unlock()
+				return
+			case <-ticker.C:
+				l.sendErrorDigest(d)
+			}
+		}
+	}()
+}
+
+func (l *Logger) currentErrorDigest() *errorDigest {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if l.state == nil {
+		return nil
+	}
+	return l.state.errorDigest
+}
+
+func (l *Logger) trackErrorDigest(entry Entry) {
+	d := l.currentErrorDigest()
+	if d == nil || levelRank(entry.Level) < levelRank(d.config.Level) {
+		return
+	}
+
+	signature := messageSignature(entry.Level, entry.Message)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	group, ok := d.groups[signature]
+	if !ok {
+		if len(d.groups) >= maxErrorDigestGroups {
+			d.overflow++
+			return
+		}
+		group = &DigestGroup{
+			Level:     entry.Level,
+			Signature: signature,
+			Message:   strings.TrimSpace(entry.Message),
+			First:     entry.Time,
+		}
+		d.groups[signature] = group
+	}
+	group.Count++
+	group.Last = entry.Time
+}
+
+func (l *Logger) sendErrorDigest(d *errorDigest) {
+	now := l.now()
+	d.mu.Lock()
+	groups := make([]DigestGroup, 0, len(d.groups))
+	for _, group := range d.groups {
+		groups = append(groups, *group)
+	}
+	known := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		known[group.Signature] = d.known[group.Signature]
+		if len(d.known) < maxKnownErrorSignatures {
+			d.known[group.Signature] = true
+		}
+	}
+	since, overflow := d.since, d.overflow
+	d.groups = map[string]*DigestGroup{}
+	d.overflow = 0
+	d.since = now
+	d.mu.Unlock()
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Signature < groups[j].Signature
+	})
+
+	total, newCount := overflow, 0
+	for _, group := range groups {
+		total += group.Count
+		if !known[group.Signature] {
+			newCount++
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Error digest: %d errors (%d distinct, %d new) since %s", total, len(groups), newCount, since.Format(time.RFC3339))
+	for i, group := range groups {
+		if i == d.config.TopN {
+			fmt.Fprintf(&sb, "\n...and %d more", len(groups)-i)
+			break
+		}
+		status := "recurring"
+		if !known[group.Signature] {
+			status = "new"
+		}
+		fmt.Fprintf(&sb, "\n%dx [%s] (%s) %s", group.Count, group.Level, status, group.Message)
+	}
+	if overflow > 0 {
+		fmt.Fprintf(&sb, "\n%dx in groups beyond the %d tracked", overflow, maxErrorDigestGroups)
+	}
+
+	level := INFO
+	if total > 0 {
+		level = WARN
+	}
+	entry := l.newEntry(level, sb.String())
+	if entry.Fields == nil {
+		entry.Fields = Fields{}
+	}
+	entry.Fields["digest_total"] = total
+	entry.Fields["digest_groups"] = len(groups)
+	entry.Fields["digest_new"] = newCount
+	if overflow > 0 {
+		entry.Fields["digest_overflow"] = overflow
+	}
+
+	l.write(entry)
+	l.notify(entry)
+	l.deliverWebhook(entry)
+}
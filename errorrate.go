@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type ErrorRateAlert struct {
+	Threshold int
+	Window    time.Duration
+	Cooldown  time.Duration
+}
+
+const errorRateBuckets = 60
+
+type errorRateMonitor struct {
+	mu       sync.Mutex
+	width    time.Duration
+	buckets  [errorRateBuckets]errorRateBucket
+	quietTil time.Time
+}
+
+type errorRateBucket struct {
+	index int64
+	count int
+}
+
+func (l *Logger) errorRateMonitor() *errorRateMonitor {
+	state := l.shared()
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if state.errorRate == nil {
+		state.errorRate = &errorRateMonitor{}
+	}
+	return state.errorRate
+}
+
+func (l *Logger) trackErrorRate(entry Entry) {
+	alert := l.ErrorRateAlert
+	if alert == nil || alert.Threshold <= 0 || levelRank(entry.Level) < levelRank(ERR) {
+		return
+	}
+	window := alert.Window
+	if window <= 0 {
+		window = time.Minute
+	}
+	cooldown := alert.Cooldown
+	if cooldown <= 0 {
+		cooldown = window
+	}
+
+	m := l.errorRateMonitor()
+	m.mu.Lock()
+	now := entry.Time
+	count := m.add(now, window)
+	fire := count > alert.Threshold && !now.Before(m.quietTil)
+	if fire {
+		m.quietTil = now.Add(cooldown)
+		m.buckets = [errorRateBuckets]errorRateBucket{}
+	}
+	m.mu.Unlock()
+
+	if fire {
+		l.escalate(count, window)
+	}
+}
+
+func (m *errorRateMonitor) add(now time.Time, window time.Duration) int {
+	width := max(window/errorRateBuckets, 1)
+	if width != m.width {
+		m.width = width
+		m.buckets = [errorRateBuckets]errorRateBucket{}
+	}
+	index := now.UnixNano() / int64(width)
+	bucket := &m.buckets[(index%errorRateBuckets+errorRateBuckets)%errorRateBuckets]
+	if bucket.index != index {
+		*bucket = errorRateBucket{index: index}
+	}
+	bucket.count++
+
+	count := 0
+	for _, b := range m.buckets {
+		if b.index > index-errorRateBuckets && b.index <= index {
+			count += b.count
+		}
+	}
+	return count
+}
+
+func (l *Logger) escalate(count int, window time.Duration) {
+	entry := l.newEntry(ERR, fmt.Sprintf("Error rate exceeded: %d errors in the last %s", count, window))
+	if entry.Fields == nil {
+		entry.Fields = Fields{}
+	}
+	entry.Fields["error_count"] = count
+	entry.Fields["window"] = window.String()
+	entry.Fields["escalation"] = true
+
+	l.write(entry)
+	l.notify(entry)
+	l.deliverWebhook(entry)
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	SuppressedField  = "suppressed"
+	maxThrottledKeys = 10000
+)
+
+type throttle struct {
+	last       time.Time
+	suppressed int
+}
+
+var (
+	everyMu        sync.Mutex
+	everyThrottles = newLRU[*throttle](maxThrottledKeys)
+)
+
+func (l *Logger) LogEvery(logLevel string, interval time.Duration, format string, v ...any) {
+	key := format
+	if frame := caller(); frame != nil {
+		key = frame.File + ":" + strconv.Itoa(frame.Line)
+	}
+	l.LogEveryKey(logLevel, key, interval, format, v...)
+}
+
+func (l *Logger) LogEveryKey(logLevel string, key string, interval time.Duration, format string, v ...any) {
+	suppressed, ok := l.throttled(key, interval)
+	if !ok {
+		return
+	}
+	child := l
+	if suppressed > 0 {
+		child = l.withRootFields(Fields{SuppressedField: suppressed})
+	}
+	child.emit(logLevel, format, v, l.WebhookConfig.sends(logLevel), levelRank(logLevel) >= levelRank(ERR))
+}
+
+func (l *Logger) InfoEvery(interval time.Duration, format string, v ...any) {
+	l.LogEvery(INFO, interval, format, v...)
+}
+
+func (l *Logger) WarnEvery(interval time.Duration, format string, v ...any) {
+	l.LogEvery(WARN, interval, format, v...)
+}
+
+func (l *Logger) ErrorEvery(interval time.Duration, format string, v ...any) {
+	l.LogEvery(ERR, interval, format, v...)
+}
+
+func (l *Logger) throttled(key string, interval time.Duration) (int, bool) {
+	now := l.now()
+	everyMu.Lock()
+	defer everyMu.Unlock()
+	t, ok := everyThrottles.get(key)
+	if !ok {
+		everyThrottles.put(key, &throttle{last: now})
+		return 0, true
+	}
+	if now.Sub(t.last) < interval {
+		t.suppressed++
+		return 0, false
+	}
+	suppressed := t.suppressed
+	t.last = now
+	t.suppressed = 0
+	return suppressed, true
+}
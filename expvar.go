@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"expvar"
+	"fmt"
+)
+
+func (l *Logger) PublishExpvar(name string) error {
+	if expvar.Get(name) != nil {
+		return fmt.Errorf("expvar %q is already published", name)
+	}
+	expvar.Publish(name, expvar.Func(func() any {
+		levels := map[string]uint64{}
+		for _, count := range l.EntryCounts() {
+			levels[count.Level] += count.Count
+		}
+		stats := l.WebhookStats()
+		return map[string]any{
+			"entries":          levels,
+			"dropped":          l.DroppedEntries(),
+			"webhook_sent":     stats.Sent,
+			"webhook_failures": stats.Failed,
+			"webhook_dropped":  stats.Dropped,
+			"webhook_queue":    stats.QueueDepth,
+		}
+	}))
+	return nil
+}
@@ -0,0 +1,171 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+type fieldKind uint8
+
+const (
+	anyKind fieldKind = iota
+	stringKind
+	intKind
+	uintKind
+	floatKind
+	boolKind
+	durationKind
+)
+
+type Field struct {
+	Key   string
+	kind  fieldKind
+	num   uint64
+	str   string
+	iface any
+}
+
+type signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type float interface {
+	~float32 | ~float64
+}
+
+func String(key string, v string) Field {
+	return Field{Key: key, kind: stringKind, str: v}
+}
+
+func Int[T signed](key string, v T) Field {
+	return Field{Key: key, kind: intKind, num: uint64(int64(v))}
+}
+
+func Uint[T unsigned](key string, v T) Field {
+	return Field{Key: key, kind: uintKind, num: uint64(v)}
+}
+
+func Float[T float](key string, v T) Field {
+	return Field{Key: key, kind: floatKind, num: math.Float64bits(float64(v))}
+}
+
+func Bool(key string, v bool) Field {
+	f := Field{Key: key, kind: boolKind}
+	if v {
+		f.num = 1
+	}
+	return f
+}
+
+func Dur(key string, d time.Duration) Field {
+	return Field{Key: key, kind: durationKind, num: uint64(d)}
+}
+
+func Time(key string, t time.Time) Field {
+	return Field{Key: key, iface: t}
+}
+
+func Err(err error) Field {
+	return NamedErr("error", err)
+}
+
+func NamedErr(key string, err error) Field {
+	return Field{Key: key, iface: err}
+}
+
+func Stringer(key string, v fmt.Stringer) Field {
+	return Field{Key: key, iface: v}
+}
+
+func Any(key string, v any) Field {
+	return Field{Key: key, iface: v}
+}
+
+func (f Field) Value() any {
+	switch f.kind {
+	case stringKind:
+		return f.str
+	case intKind:
+		return int64(f.num)
+	case uintKind:
+		return f.num
+	case floatKind:
+		return math.Float64frombits(f.num)
+	case boolKind:
+		return f.num == 1
+	case durationKind:
+		return time.Duration(f.num)
+	default:
+		return f.iface
+	}
+}
+
+func (f Field) String() string {
+	switch f.kind {
+	case stringKind:
+		return f.str
+	case intKind:
+		return strconv.FormatInt(int64(f.num), 10)
+	case uintKind:
+		return strconv.FormatUint(f.num, 10)
+	case floatKind:
+		return strconv.FormatFloat(math.Float64frombits(f.num), 'g', -1, 64)
+	case boolKind:
+		return strconv.FormatBool(f.num == 1)
+	case durationKind:
+		return time.Duration(f.num).String()
+	default:
+		return fmt.Sprint(f.iface)
+	}
+}
+
+func (f Field) MarshalJSON() ([]byte, error) {
+	return f.appendJSON(nil), nil
+}
+
+func (f Field) appendJSON(b []byte) []byte {
+	switch f.kind {
+	case stringKind:
+		quoted, _ := json.Marshal(f.str)
+		return append(b, quoted...)
+	case intKind, durationKind:
+		return strconv.AppendInt(b, int64(f.num), 10)
+	case uintKind:
+		return strconv.AppendUint(b, f.num, 10)
+	case floatKind:
+		v := math.Float64frombits(f.num)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return strconv.AppendQuote(b, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+		return strconv.AppendFloat(b, v, 'g', -1, 64)
+	case boolKind:
+		return strconv.AppendBool(b, f.num == 1)
+	default:
+		return append(b, marshalJSONValue(f.iface)...)
+	}
+}
+
+func fieldValue(v any) any {
+	if f, ok := v.(Field); ok {
+		return f.Value()
+	}
+	return v
+}
+
+func (l *Logger) With(fields ...Field) *Logger {
+	if len(fields) == 0 {
+		return l
+	}
+	values := make(Fields, len(fields))
+	for _, f := range fields {
+		values[f.Key] = f
+	}
+	return l.WithFields(values)
+}
@@ -0,0 +1,13 @@
+//go:build !unix && !windows
+
+package logger
+
+import "os"
+
+func lockFile(f *os.File) error {
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+type FileSink struct {
+	Path        string
+	Perm        os.FileMode
+	Encoder     Encoder
+	SyncOnError bool
+	SyncEvery   int
+	Lock        bool
+	Fallback    io.Writer
+
+	mu     sync.Mutex
+	file   *os.File
+	writes int
+	closed bool
+}
+
+func (f *FileSink) Write(entry Entry) error {
+	encoder := f.Encoder
+	if encoder == nil {
+		encoder = JSONEncoder{}
+	}
+	b, err := encoder.Encode(entry)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return ErrSinkClosed
+	}
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return f.fallback(b, err)
+		}
+	}
+	if err := f.write(b); err != nil {
+		return f.fallback(b, err)
+	}
+
+	f.writes++
+	if (f.SyncOnError && levelRank(entry.Level) >= levelRank(ERR)) || (f.SyncEvery > 0 && f.writes%f.SyncEvery == 0) {
+		return f.file.Sync()
+	}
+	return nil
+}
+
+func (f *FileSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	if f.file == nil {
+		return nil
+	}
+	syncErr := f.file.Sync()
+	closeErr := f.file.Close()
+	f.file = nil
+	if closeErr != nil {
+		return closeErr
+	}
+	return syncErr
+}
+
+func (f *FileSink) fallback(b []byte, err error) error {
+	w := f.Fallback
+	if w == nil {
+		w = os.Stderr
+	}
+	_, _ = w.Write(b)
+	return fmt.Errorf("file sink %s: %w", f.Path, err)
+}
+
+func (f *FileSink) write(b []byte) error {
+	if !f.Lock {
+		_, err := f.file.Write(b)
+		return err
+	}
+	if err := lockFile(f.file); err != nil {
+		return err
+	}
+	_, err := f.file.Write(b)
+	if unlockErr := unlockFile(f.file); err == nil {
+		err = unlockErr
+	}
+	return err
+}
+
+func (f *FileSink) open() error {
+	perm := f.Perm
+	if perm == 0 {
+		perm = 0o644
+	}
+	file, err := os.OpenFile(f.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, perm)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	return nil
+}
@@ -0,0 +1,144 @@
+package logger
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const defaultFluentdTag = "{{.Service}}.{{.Level}}"
+
+type FluentdSink struct {
+	Address        string
+	Network        string
+	Tag            string
+	BatchSize      int
+	FlushInterval  time.Duration
+	Timeout        time.Duration
+	ErrorHandler   func(err error)
+	OverflowPolicy string
+
+	once    sync.Once
+	batcher *entryBatcher
+	tag     *template.Template
+	tagErr  error
+	conn    net.Conn
+}
+
+func (f *FluentdSink) Write(entry Entry) error {
+	b := f.batch()
+	if f.tagErr != nil {
+		return f.tagErr
+	}
+	return b.add(entry)
+}
+
+func (f *FluentdSink) Close() error {
+	err := f.batch().close()
+	if f.conn != nil {
+		_ = f.conn.Close()
+		f.conn = nil
+	}
+	return err
+}
+
+func (f *FluentdSink) Dropped() uint64 {
+	return f.batch().dropped.Load()
+}
+
+func (f *FluentdSink) batch() *entryBatcher {
+	f.once.Do(func() {
+		tag := f.Tag
+		if tag == "" {
+			tag = defaultFluentdTag
+		}
+		f.tag, f.tagErr = template.New("tag").Funcs(webhookTemplateFuncs).Parse(tag)
+		f.batcher = newEntryBatcher(f.BatchSize, f.FlushInterval, f.OverflowPolicy, f.send, f.ErrorHandler)
+	})
+	return f.batcher
+}
+
+func (f *FluentdSink) send(entries []Entry) error {
+	tags := []string{}
+	byTag := map[string][]Entry{}
+	for _, entry := range entries {
+		var tag bytes.Buffer
+		if err := f.tag.Execute(&tag, entry); err != nil {
+			return err
+		}
+		key := strings.ToLower(tag.String())
+		if _, ok := byTag[key]; !ok {
+			tags = append(tags, key)
+		}
+		byTag[key] = append(byTag[key], entry)
+	}
+
+	var msg []byte
+	for _, tag := range tags {
+		msg = appendFluentdForward(msg, tag, byTag[tag])
+	}
+
+	if err := f.writeMessage(msg); err != nil {
+		if f.conn != nil {
+			_ = f.conn.Close()
+			f.conn = nil
+		}
+		return f.writeMessage(msg)
+	}
+	return nil
+}
+
+func (f *FluentdSink) writeMessage(msg []byte) error {
+	timeout := f.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	if f.conn == nil {
+		network := f.Network
+		if network == "" {
+			network = "tcp"
+		}
+		address := f.Address
+		if address == "" {
+			address = "127.0.0.1:24224"
+		}
+		conn, err := net.DialTimeout(network, address, timeout)
+		if err != nil {
+			return err
+		}
+		f.conn = conn
+	}
+
+	_ = f.conn.SetWriteDeadline(time.Now().Add(timeout))
+	_, err := f.conn.Write(msg)
+	return err
+}
+
+func appendFluentdForward(b []byte, tag string, entries []Entry) []byte {
+	b = appendMsgpackArrayHeader(b, 2)
+	b = appendMsgpackString(b, tag)
+	b = appendMsgpackArrayHeader(b, len(entries))
+	for _, entry := range entries {
+		record := map[string]any{
+			"level":    entry.Level,
+			"severity": GCPSeverity(entry.Level),
+			"service":  entry.Service,
+			"message":  strings.TrimRight(entry.Message, "\n"),
+		}
+		if entry.Context != "" {
+			record["context"] = entry.Context
+		}
+		for k, v := range entry.Fields {
+			if _, ok := record[k]; !ok {
+				record[k] = v
+			}
+		}
+		b = appendMsgpackArrayHeader(b, 2)
+		b = appendMsgpackEventTime(b, entry.Time)
+		b = appendMsgpackMap(b, record)
+	}
+	return b
+}
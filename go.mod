@@ -1,3 +1,3 @@
 module github.com/gomessguii/logger
 
-go 1.22.2
+go 1.24
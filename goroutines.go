@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+)
+
+const GoroutineDumpLimit = 1 << 20
+
+func (l *Logger) LogGoroutines(logLevel string) {
+	dump, truncated := goroutineDump(GoroutineDumpLimit)
+	if truncated {
+		dump = append(dump, "\n... truncated"...)
+	}
+	l.logGoroutines(logLevel, dump)
+}
+
+func (l *Logger) logGoroutines(logLevel string, dump []byte) {
+	l.WithField("goroutines", runtime.NumGoroutine()).emit(logLevel, "goroutine dump:\n%s", []any{dump}, false, false)
+}
+
+func (l *Logger) GoroutinesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		logLevel := req.URL.Query().Get("level")
+		if logLevel == "" {
+			logLevel = INFO
+		}
+		dump, truncated := goroutineDump(GoroutineDumpLimit)
+		if truncated {
+			dump = append(dump, "\n... truncated"...)
+		}
+		l.logGoroutines(logLevel, dump)
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write(dump)
+	})
+}
+
+func (l *Logger) LogGoroutinesOnSignal(ctx context.Context, logLevel string, signals ...os.Signal) {
+	if len(signals) == 0 {
+		return
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				l.LogGoroutines(logLevel)
+			}
+		}
+	}()
+}
+
+func goroutineDump(limit int) ([]byte, bool) {
+	buf := make([]byte, min(64<<10, limit))
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n], false
+		}
+		if len(buf) >= limit {
+			return buf[:n], true
+		}
+		buf = make([]byte, min(2*len(buf), limit))
+	}
+}
@@ -0,0 +1,52 @@
+package logger
+
+func (l *Logger) Group(name string) *Logger {
+	child := l.clone()
+	child.groups = append(l.groups[:len(l.groups):len(l.groups)], name)
+	return child
+}
+
+func (l *Logger) withRootFields(fields Fields) *Logger {
+	root := l.clone()
+	root.groups = nil
+	child := root.WithFields(fields)
+	child.groups = l.groups
+	return child
+}
+
+func (l *Logger) groupFields(fields Fields) Fields {
+	merged := make(Fields, len(l.Fields)+len(fields))
+	for k, v := range l.Fields {
+		merged[k] = v
+	}
+	target := merged
+	for _, name := range l.groups {
+		group := Fields{}
+		if existing, ok := target[name].(Fields); ok {
+			for k, v := range existing {
+				group[k] = v
+			}
+		}
+		target[name] = group
+		target = group
+	}
+	for k, v := range fields {
+		target[k] = v
+	}
+	return merged
+}
+
+func flattenFields(fields Fields) Fields {
+	flat := make(Fields, len(fields))
+	for k, v := range fields {
+		nested, ok := v.(Fields)
+		if !ok {
+			flat[k] = v
+			continue
+		}
+		for nk, nv := range flattenFields(nested) {
+			flat[k+"."+nk] = nv
+		}
+	}
+	return flat
+}
@@ -0,0 +1,236 @@
+package logger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultGRPCMethod       = "/logger.v1.LogCollector/Stream"
+	defaultGRPCQueueSize    = 1024
+	defaultGRPCReconnect    = 500 * time.Millisecond
+	defaultGRPCMaxReconnect = 30 * time.Second
+)
+
+var ErrSinkBackpressure = errors.New("sink queue is full")
+
+type GRPCSink struct {
+	Target       string
+	Method       string
+	Headers      map[string]string
+	HTTPClient   *http.Client
+	QueueSize    int
+	BlockTimeout time.Duration
+	ErrorHandler func(err error)
+
+	once   sync.Once
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Entry
+	stop   chan struct{}
+	done   chan struct{}
+	err    error
+}
+
+type grpcStream struct {
+	body   *io.PipeWriter
+	result chan error
+}
+
+func (s *GRPCSink) Write(entry Entry) error {
+	s.start()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrSinkClosed
+	}
+
+	select {
+	case s.queue <- entry:
+		return nil
+	default:
+	}
+	if s.BlockTimeout <= 0 {
+		return ErrSinkBackpressure
+	}
+	timer := time.NewTimer(s.BlockTimeout)
+	defer timer.Stop()
+	select {
+	case s.queue <- entry:
+		return nil
+	case <-timer.C:
+		return ErrSinkBackpressure
+	}
+}
+
+func (s *GRPCSink) Close() error {
+	s.start()
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+		close(s.stop)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return s.err
+}
+
+func (s *GRPCSink) start() {
+	s.once.Do(func() {
+		size := s.QueueSize
+		if size <= 0 {
+			size = defaultGRPCQueueSize
+		}
+		s.queue = make(chan Entry, size)
+		s.stop = make(chan struct{})
+		s.done = make(chan struct{})
+		go s.run()
+	})
+}
+
+func (s *GRPCSink) run() {
+	defer close(s.done)
+
+	var (
+		stream  *grpcStream
+		pending []byte
+		delay   = defaultGRPCReconnect
+	)
+	for {
+		if pending == nil {
+			entry, ok := <-s.queue
+			if !ok {
+				if stream != nil {
+					s.err = stream.finish()
+				}
+				return
+			}
+			pending = grpcFrame(appendProtoEntry(nil, entry))
+		}
+
+		if stream == nil {
+			stream = s.open()
+		}
+		if err := stream.send(pending); err != nil {
+			s.handleError(fmt.Errorf("grpc stream to %s failed: %w", s.Target, err))
+			_ = stream.body.CloseWithError(err)
+			stream = nil
+			if !s.sleep(delay) {
+				s.err = err
+				return
+			}
+			delay = min(delay*2, defaultGRPCMaxReconnect)
+			continue
+		}
+		pending = nil
+		delay = defaultGRPCReconnect
+	}
+}
+
+func (s *GRPCSink) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-s.stop:
+		return false
+	}
+}
+
+func (s *GRPCSink) open() *grpcStream {
+	reader, writer := io.Pipe()
+	stream := &grpcStream{body: writer, result: make(chan error, 1)}
+
+	method := s.Method
+	if method == "" {
+		method = defaultGRPCMethod
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimRight(s.Target, "/")+method, reader)
+	if err != nil {
+		_ = reader.CloseWithError(err)
+		stream.result <- err
+		return stream
+	}
+	req.Header.Set("Content-Type", "application/grpc+proto")
+	req.Header.Set("TE", "trailers")
+	for k, v := range s.Headers {
+		req.Header.Set(k, v)
+	}
+
+	client := s.HTTPClient
+	if client == nil {
+		client = grpcClient
+	}
+	go func() {
+		resp, err := client.Do(req)
+		if err != nil {
+			_ = reader.CloseWithError(err)
+			stream.result <- err
+			return
+		}
+		err = grpcResult(resp)
+		_ = reader.CloseWithError(err)
+		stream.result <- err
+	}()
+	return stream
+}
+
+func (st *grpcStream) send(frame []byte) error {
+	if _, err := st.body.Write(frame); err != nil {
+		select {
+		case result := <-st.result:
+			if result != nil {
+				return result
+			}
+		default:
+		}
+		return err
+	}
+	return nil
+}
+
+func (st *grpcStream) finish() error {
+	_ = st.body.Close()
+	return <-st.result
+}
+
+func (s *GRPCSink) handleError(err error) {
+	if s.ErrorHandler != nil {
+		s.ErrorHandler(err)
+	}
+}
+
+func grpcFrame(message []byte) []byte {
+	frame := make([]byte, 5, 5+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+	return append(frame, message...)
+}
+
+func grpcResult(resp *http.Response) error {
+	defer func(body io.ReadCloser) {
+		_ = body.Close()
+	}(resp.Body)
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("grpc collector responded with status %s", resp.Status)
+	}
+	status := resp.Trailer.Get("Grpc-Status")
+	message := resp.Trailer.Get("Grpc-Message")
+	if status == "" {
+		status = resp.Header.Get("Grpc-Status")
+		message = resp.Header.Get("Grpc-Message")
+	}
+	if status != "" && status != "0" {
+		return fmt.Errorf("grpc collector returned status %s: %s", status, message)
+	}
+	return nil
+}
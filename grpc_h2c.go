@@ -0,0 +1,12 @@
+package logger
+
+import "net/http"
+
+var grpcClient = func() *http.Client {
+	var protocols http.Protocols
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Protocols = &protocols
+	return &http.Client{Transport: transport}
+}()
@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"context"
+	"time"
+)
+
+type HeartbeatConfig struct {
+	Interval time.Duration
+	Message  string
+	Level    string
+	Counters func() Fields
+}
+
+func (l *Logger) StartHeartbeat(ctx context.Context, config HeartbeatConfig) {
+	if config.Interval <= 0 {
+		config.Interval = time.Minute
+	}
+	if config.Message == "" {
+		config.Message = "heartbeat: service alive"
+	}
+	if config.Level == "" {
+		config.Level = INFO
+	}
+
+	started := l.now()
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				l.heartbeat(config, started)
+			}
+		}
+	}()
+}
+
+func (l *Logger) heartbeat(config HeartbeatConfig, started time.Time) {
+	fields := Fields{"uptime": l.now().Sub(started).Round(time.Second)}
+	if config.Counters != nil {
+		for k, v := range config.Counters() {
+			fields[k] = v
+		}
+	}
+	l.WithFields(fields).Log(config.Level, "%s", config.Message)
+}
@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+func (l *Logger) LogHexDump(logLevel string, label string, data []byte) {
+	if !l.enabled(logLevel) {
+		return
+	}
+	l.Log(logLevel, "%s", HexDump(label, data, l.DumpConfig.MaxHexBytes))
+}
+
+func HexDump(label string, data []byte, maxBytes int) string {
+	if maxBytes <= 0 {
+		maxBytes = 512
+	}
+	shown := data
+	if len(shown) > maxBytes {
+		shown = shown[:maxBytes]
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%d bytes):\n", label, len(data))
+	sb.WriteString(strings.TrimSuffix(hex.Dump(shown), "\n"))
+	if len(data) > len(shown) {
+		fmt.Fprintf(&sb, "\n... (%d more bytes)", len(data)-len(shown))
+	}
+	return sb.String()
+}
@@ -0,0 +1,21 @@
+package logger
+
+import "slices"
+
+var AllLevels = []string{TRACE, DEBUG, INFO, WARN, ERR, FATAL}
+
+type Hook interface {
+	Levels() []string
+	Fire(entry Entry) error
+}
+
+func (l *Logger) fireHooks(entry Entry) {
+	for _, hook := range l.Hooks {
+		if !slices.Contains(hook.Levels(), entry.Level) {
+			continue
+		}
+		if err := hook.Fire(entry); err != nil {
+			l.logInternal("Failed to fire hook: %v\n", err)
+		}
+	}
+}
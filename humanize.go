@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"strconv"
+	"time"
+)
+
+type ByteSize int64
+
+func (b ByteSize) String() string {
+	return FormatBytes(int64(b))
+}
+
+type Count int64
+
+func (c Count) String() string {
+	return FormatCount(int64(c))
+}
+
+func FormatDuration(d time.Duration) string {
+	abs := d.Abs()
+	switch {
+	case abs >= time.Minute:
+		return d.Round(time.Second).String()
+	case abs >= time.Second:
+		return d.Round(100 * time.Millisecond).String()
+	case abs >= time.Millisecond:
+		return d.Round(100 * time.Microsecond).String()
+	case abs >= time.Microsecond:
+		return d.Round(100 * time.Nanosecond).String()
+	default:
+		return d.String()
+	}
+}
+
+func FormatBytes(n int64) string {
+	const unit = 1024
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs < unit {
+		return strconv.FormatInt(n, 10) + "B"
+	}
+	value := float64(n)
+	exp := 0
+	for abs >= unit && exp < 6 {
+		value /= unit
+		abs /= unit
+		exp++
+	}
+	return strconv.FormatFloat(value, 'f', 1, 64) + string("KMGTPE"[exp-1]) + "iB"
+}
+
+func FormatCount(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	out := make([]byte, 0, len(digits)+len(digits)/3)
+	for i := range len(digits) {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, digits[i])
+	}
+	return sign + string(out)
+}
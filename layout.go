@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"strings"
+	"unicode"
+)
+
+const DefaultLayout = "%time [%service] [%level] %msg %fields %caller"
+
+var layoutTokens = []string{"time", "level", "service", "ctx", "msg", "fields", "caller"}
+
+type LayoutEncoder struct {
+	Layout     string
+	TimeFormat string
+	Colors     ColorScheme
+	Stack      StackFormat
+	Levels     LevelFormat
+}
+
+type layoutSegment struct {
+	literal string
+	token   string
+}
+
+func (e LayoutEncoder) Encode(entry Entry) ([]byte, error) {
+	layout := e.Layout
+	if layout == "" {
+		layout = DefaultLayout
+	}
+
+	var sb strings.Builder
+	skipSpace := false
+	for _, segment := range parseLayout(layout) {
+		if segment.token == "" {
+			literal := segment.literal
+			if skipSpace {
+				literal = strings.TrimLeftFunc(literal, unicode.IsSpace)
+			}
+			sb.WriteString(literal)
+			skipSpace = false
+			continue
+		}
+		value := e.render(segment.token, entry)
+		sb.WriteString(value)
+		skipSpace = value == ""
+	}
+
+	line := strings.TrimRightFunc(sb.String(), unicode.IsSpace)
+	if len(entry.Stack) > 0 {
+		line += e.Stack.text(entry.Stack)
+	}
+	return []byte(line + "\n"), nil
+}
+
+func (e LayoutEncoder) render(token string, entry Entry) string {
+	switch token {
+	case "time":
+		format := e.TimeFormat
+		if format == "" {
+			format = textTimeFormat
+		}
+		return entry.Time.Format(format)
+	case "level":
+		return e.Levels.render(e.Colors, entry.Level, false)
+	case "service":
+		return colorize(e.Colors.Service, entry.Service)
+	case "ctx":
+		return entry.Context
+	case "msg":
+		return strings.TrimRight(entry.Message, "\n")
+	case "fields":
+		if len(entry.Fields) == 0 {
+			return ""
+		}
+		return formatFields(entry.Fields)
+	case "caller":
+		if entry.Caller == nil {
+			return ""
+		}
+		return entry.Caller.String()
+	}
+	return ""
+}
+
+func parseLayout(layout string) []layoutSegment {
+	var segments []layoutSegment
+	var literal strings.Builder
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			literal.WriteByte(layout[i])
+			continue
+		}
+		if strings.HasPrefix(layout[i+1:], "%") {
+			literal.WriteByte('%')
+			i++
+			continue
+		}
+		token := ""
+		for _, candidate := range layoutTokens {
+			if strings.HasPrefix(layout[i+1:], candidate) {
+				token = candidate
+				break
+			}
+		}
+		if token == "" {
+			literal.WriteByte('%')
+			continue
+		}
+		if literal.Len() > 0 {
+			segments = append(segments, layoutSegment{literal: literal.String()})
+			literal.Reset()
+		}
+		segments = append(segments, layoutSegment{token: token})
+		i += len(token)
+	}
+	if literal.Len() > 0 {
+		segments = append(segments, layoutSegment{literal: literal.String()})
+	}
+	return segments
+}
@@ -0,0 +1,86 @@
+package logger
+
+import "strings"
+
+const levelLabelWidth = 5
+
+var UnicodeLevelIcons = map[string]string{
+	TRACE: "·",
+	DEBUG: "•",
+	INFO:  "ℹ",
+	WARN:  "▲",
+	ERR:   "✖",
+	FATAL: "☠",
+}
+
+var EmojiLevelIcons = map[string]string{
+	TRACE: "🔍",
+	DEBUG: "🐛",
+	INFO:  "💡",
+	WARN:  "⚠️",
+	ERR:   "❌",
+	FATAL: "💀",
+}
+
+type LevelFormat struct {
+	FixedWidth bool
+	Icons      map[string]string
+}
+
+func (f LevelFormat) label(logLevel string, bracketed bool) string {
+	label := levelLabel(logLevel)
+	if bracketed {
+		label = "[" + label + "]"
+	}
+	if f.FixedWidth {
+		width := levelLabelWidth
+		if bracketed {
+			width += 2
+		}
+		label += strings.Repeat(" ", max(0, width-len(label)))
+	}
+	return label
+}
+
+func (f LevelFormat) icon(logLevel string) string {
+	if f.Icons == nil {
+		return ""
+	}
+	icon := f.Icons[levelLabel(logLevel)]
+	if f.FixedWidth {
+		width := 0
+		for _, candidate := range f.Icons {
+			width = max(width, displayWidth(candidate))
+		}
+		icon += strings.Repeat(" ", max(0, width-displayWidth(icon)))
+	}
+	return icon
+}
+
+func (f LevelFormat) render(colors ColorScheme, logLevel string, bracketed bool) string {
+	label := colorize(colors.levelColor(logLevel), f.label(logLevel, bracketed))
+	if icon := f.icon(logLevel); icon != "" {
+		return icon + " " + label
+	}
+	return label
+}
+
+func displayWidth(s string) int {
+	width := 0
+	prev := rune(0)
+	for _, r := range s {
+		switch {
+		case r == 0xFE0F:
+			if prev != 0 && prev < 0x1F000 {
+				width++
+			}
+		case r == 0x200D || r == 0xFE0E:
+		case r >= 0x1F000:
+			width += 2
+		default:
+			width++
+		}
+		prev = r
+	}
+	return width
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+type lineWriter struct {
+	logger *Logger
+	level  string
+	mu     sync.Mutex
+	buf    []byte
+}
+
+func (l *Logger) LineWriter(logLevel string) io.WriteCloser {
+	return &lineWriter{logger: l, level: logLevel}
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *lineWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.buf) > 0 {
+		w.emit(w.buf)
+		w.buf = nil
+	}
+	return nil
+}
+
+func (w *lineWriter) emit(line []byte) {
+	w.logger.logLine(w.level, string(bytes.TrimSuffix(line, []byte{'\r'})))
+}
+
+func (l *Logger) logLine(logLevel string, line string) {
+	if line == "" {
+		return
+	}
+	capture := levelRank(logLevel) >= levelRank(ERR)
+	l.emitln(logLevel, []any{line}, l.WebhookConfig.sends(logLevel), capture)
+}
+
+func (l *Logger) RunCommand(cmd *exec.Cmd, label string, stdoutLevel string, stderrLevel string) error {
+	child := l.clone()
+	if label != "" {
+		child.LogContextName = label
+	}
+	stdout := child.LineWriter(stdoutLevel)
+	stderr := child.LineWriter(stderrLevel)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	_ = stdout.Close()
+	_ = stderr.Close()
+	return err
+}
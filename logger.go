@@ -3,11 +3,14 @@ package logger
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
-	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -16,13 +19,53 @@ const (
 	ERR   = "ERR"
 	WARN  = "WARN"
 	DEBUG = "DEBUG"
+	TRACE = "TRACE"
+	FATAL = "FATAL"
 )
 
-type WebhookConfig struct {
-	Url       string
-	SendError bool
-	SendFatal bool
-	SendWarn  bool
+const (
+	FormatText   = "text"
+	FormatJSON   = "json"
+	FormatPretty = "pretty"
+)
+
+var levelRanks = map[string]int{
+	TRACE: -1,
+	DEBUG: 0,
+	INFO:  1,
+	WARN:  2,
+	ERR:   3,
+	FATAL: 4,
+}
+
+type Fields map[string]any
+
+type Entry struct {
+	Level   string
+	Time    time.Time
+	Service string
+	Context string
+	Message string
+	Fields  Fields
+	Caller  *Frame
+	Err     error
+	Stack   []Frame
+}
+
+type Enricher func(e *Entry)
+
+type Filter func(entry Entry) bool
+
+type Transform func(entry Entry) Entry
+
+func (e Entry) WithField(key string, value any) Entry {
+	fields := make(Fields, len(e.Fields)+1)
+	for k, v := range e.Fields {
+		fields[k] = v
+	}
+	fields[key] = value
+	e.Fields = fields
+	return e
 }
 
 type Logger struct {
@@ -30,99 +73,479 @@ type Logger struct {
 	LogContextName       string
 	CaptureExceptionFunc func(err error)
 	WebhookConfig        WebhookConfig
+	Fields               Fields
+	Enrichers            []Enricher
+	MinLevel             string
+	Format               string
+	Sampling             *Sampling
+	Colors               *ColorScheme
+	ColorMode            string
+	DumpConfig           DumpConfig
+	SlowThreshold        time.Duration
+	Notifiers            []Notifier
+	Sinks                []Sink
+	Filters              []Filter
+	Transforms           []Transform
+	Hooks                []Hook
+	Encoder              Encoder
+	Output               io.Writer
+	LevelOutputs         map[string]io.Writer
+	ReportCaller         bool
+	Now                  func() time.Time
+	Catalog              *MessageCatalog
+	Locale               string
+	Tenants              map[string]TenantConfig
+	Compliance           *ComplianceConfig
+	Schema               *Schema
+	ErrorHandler         func(err error)
+	MeterProvider        MeterProvider
+	ErrorRateAlert       *ErrorRateAlert
+	OnDrop               func(entry Entry, reason string)
+	CrashReport          *CrashReportConfig
+	ExpandErrors         bool
+	StackFormat          StackFormat
+	Layout               string
+	LevelFormat          LevelFormat
+
+	state  *loggerState
+	groups []string
+	nop    bool
+}
+
+type loggerState struct {
+	webhooks       *webhookDispatcher
+	digest         *webhookDigest
+	tenantLimiters map[string]*tenantLimiter
+	metrics        *logMetrics
+	instruments    *loggerInstruments
+	errorRate      *errorRateMonitor
+	errorDigest    *errorDigest
+	sinkAlerts     map[string]time.Time
+
+	instrumentsOnce sync.Once
+}
+
+var (
+	stateMu sync.Mutex
+	writeMu sync.Mutex
+)
+
+func (l *Logger) shared() *loggerState {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if l.state == nil {
+		l.state = &loggerState{}
+	}
+	return l.state
 }
 
 func (l *Logger) Log(logLevel string, format string, v ...any) {
-	if logLevel == DEBUG && os.Getenv("DEBUG_ENABLED") != "1" {
+	l.emit(logLevel, format, v, false, false)
+}
+
+func (l *Logger) emit(logLevel string, format string, v []any, sendWebhook bool, capture bool) {
+	l.emitEntry(logLevel, format, v, false, sendWebhook, capture)
+}
+
+func (l *Logger) emitln(logLevel string, v []any, sendWebhook bool, capture bool) {
+	l.emitEntry(logLevel, "", v, true, sendWebhook, capture)
+}
+
+func (l *Logger) emitEntry(logLevel string, format string, v []any, ln bool, sendWebhook bool, capture bool) {
+	if l.nop {
+		return
+	}
+	if ln {
+		format = strings.TrimSuffix(fmt.Sprintln(v...), "\n")
+	}
+	enabled := l.enabled(logLevel)
+	if enabled && l.Sampling != nil && !l.Sampling.allow(logLevel, format) {
+		enabled = false
+		l.countDropped()
+		if l.OnDrop != nil {
+			message := format
+			if !ln {
+				message = fmt.Sprintf(format, v...)
+			}
+			l.drop(l.newEntry(logLevel, message), DropSampled)
+		}
+	}
+	if !enabled && !sendWebhook && !capture {
+		return
+	}
+
+	message := format
+	if !ln {
+		message = fmt.Sprintf(format, v...)
+	}
+	entry := l.newEntry(logLevel, message)
+	entry.Fields = resolveFields(entry.Fields)
+	for _, arg := range v {
+		if err, ok := arg.(error); ok {
+			entry.Err = err
+			break
+		}
+	}
+	if err, ok := fieldValue(entry.Fields["error"]).(error); ok && entry.Err == nil {
+		entry.Err = err
+	}
+	if l.ExpandErrors && entry.Err != nil {
+		entry = expandError(entry)
+	}
+	if l.ReportCaller {
+		entry.Caller = caller()
+	}
+	if !l.filter(entry) {
+		l.countDropped()
+		l.drop(entry, DropFiltered)
 		return
 	}
+	for _, transform := range l.Transforms {
+		entry = transform(entry)
+	}
+	entry, ok := l.checkCompliance(entry)
+	if !ok {
+		l.countDropped()
+		l.drop(entry, DropCompliance)
+		return
+	}
+	l.validateSchema(entry)
+	l.trackErrorRate(entry)
+	l.trackErrorDigest(entry)
+	if capture && l.CaptureExceptionFunc != nil {
+		l.CaptureExceptionFunc(errors.New(fmt.Sprintf("{%s} => %s", entry.Context, entry.Message)))
+	}
+	if enabled {
+		l.countEntry(entry)
+		l.recordEntryMetric(entry)
+		l.write(entry)
+		l.writeSinks(entry)
+		l.fireHooks(entry)
+		l.notify(entry)
+	}
+	if l.crashes(entry) {
+		l.writeCrashReport(entry)
+	}
+	if _, routed := l.WebhookConfig.codeUrls(entry); sendWebhook || routed {
+		l.sendWebhook(entry)
+	}
+}
+
+func (l *Logger) logInternal(format string, v ...any) {
+	l.write(l.newEntry(ERR, fmt.Sprintf(format, v...)))
+}
 
-	var prefix string
-	switch logLevel {
-	case ERR:
-		prefix += "\033[41m[ERR]\033[0m "
-	case WARN:
-		prefix += "\033[43m[WARN]\033[0m "
-	case DEBUG:
-		prefix += "\033[40m\033[37m[DEBUG]\033[0m "
+func (l *Logger) write(entry Entry) {
+	w, custom := l.output(entry.Level)
+	if l.Encoder == nil && l.Layout == "" && !custom && l.format() != FormatJSON && l.format() != FormatPretty {
+		consoleLog().Print(formatText(entry, l.colorScheme(w), l.StackFormat, l.LevelFormat))
+		return
+	}
+	if b, err := l.ConsoleEncoder(w).Encode(entry); err == nil {
+		writeMu.Lock()
+		_, _ = w.Write(b)
+		writeMu.Unlock()
+	}
+}
+
+func (l *Logger) ConsoleEncoder(w io.Writer) Encoder {
+	if l.Encoder != nil {
+		return l.Encoder
+	}
+	switch l.format() {
+	case FormatJSON:
+		return JSONEncoder{Stack: l.StackFormat}
+	case FormatPretty:
+		return PrettyEncoder{Colors: l.colorScheme(w), Stack: l.StackFormat, Levels: l.LevelFormat}
 	default:
-		prefix += "\033[44m[INFO]\033[0m "
+		if l.Layout != "" {
+			return LayoutEncoder{Layout: l.Layout, Colors: l.colorScheme(w), Stack: l.StackFormat, Levels: l.LevelFormat}
+		}
+		return TextEncoder{Colors: l.colorScheme(w), Stack: l.StackFormat, Levels: l.LevelFormat}
 	}
-	servicePrefix := fmt.Sprintf("\033[35m[%s]\033[0m ", l.ServiceName)
-	prefix = servicePrefix + prefix + format
-	log.Printf(prefix, v...)
 }
 
-func (l *Logger) LogInfo(format string, v ...any) {
-	l.Log(INFO, format, v...)
+func (l *Logger) format() string {
+	if l.Compliance != nil {
+		return FormatJSON
+	}
+	return l.Format
 }
 
-func (l *Logger) LogError(format string, v ...any) {
-	if l.CaptureExceptionFunc != nil {
-		l.CaptureExceptionFunc(fmt.Errorf(fmt.Sprintf("{%s} => %s", l.LogContextName, fmt.Sprintf(format, v...))))
+func (l *Logger) output(logLevel string) (io.Writer, bool) {
+	if w, ok := l.LevelOutputs[levelLabel(logLevel)]; ok && w != nil {
+		return w, true
 	}
-	l.Log(ERR, format, v...)
-	if l.WebhookConfig.SendError {
-		l.sendWebhook(ERR, format, v...)
+	if l.Output != nil {
+		return l.Output, true
 	}
+	return consoleLog().Writer(), false
 }
 
-func (l *Logger) LogFatal(format string, v ...any) {
-	if l.CaptureExceptionFunc != nil {
-		l.CaptureExceptionFunc(fmt.Errorf(fmt.Sprintf("{%s} => %s", l.LogContextName, fmt.Sprintf(format, v...))))
+func StandardStreams() map[string]io.Writer {
+	return map[string]io.Writer{
+		TRACE: os.Stdout,
+		DEBUG: os.Stdout,
+		INFO:  os.Stdout,
+		WARN:  os.Stderr,
+		ERR:   os.Stderr,
+		FATAL: os.Stderr,
 	}
-	l.Log(ERR, format, v...)
-	if l.WebhookConfig.SendFatal {
-		l.sendWebhook(ERR, format, v...)
+}
+
+func formatText(entry Entry, colors ColorScheme, stack StackFormat, levels LevelFormat) string {
+	prefix := levels.render(colors, entry.Level, true)
+	servicePrefix := colorize(colors.Service, fmt.Sprintf("[%s]", entry.Service))
+	line := servicePrefix + " " + prefix + " " + entry.Message
+	if len(entry.Fields) > 0 {
+		line = strings.TrimSuffix(line, "\n") + " " + formatFields(entry.Fields)
 	}
-	os.Exit(1)
+	if entry.Caller != nil {
+		line = strings.TrimSuffix(line, "\n") + " caller=" + entry.Caller.String()
+	}
+	if len(entry.Stack) > 0 {
+		line = strings.TrimSuffix(line, "\n") + stack.text(entry.Stack)
+	}
+	return line
 }
 
-func (l *Logger) LogWarn(format string, v ...any) {
-	l.Log(WARN, format, v...)
-	if l.WebhookConfig.SendWarn {
-		l.sendWebhook(WARN, format, v...)
+func (l *Logger) filter(entry Entry) bool {
+	for _, keep := range l.Filters {
+		if !keep(entry) {
+			return false
+		}
 	}
+	return true
 }
 
-func (l *Logger) LogDebug(format string, v ...any) {
-	l.Log(DEBUG, format, v...)
+func (l *Logger) enabled(logLevel string) bool {
+	if l.nop {
+		return false
+	}
+	if !VerboseEnabled && levelRank(logLevel) <= levelRanks[DEBUG] {
+		return false
+	}
+	if l.MinLevel == "" {
+		rank := levelRank(logLevel)
+		return rank > levelRanks[DEBUG] || rank == levelRanks[DEBUG] && os.Getenv("DEBUG_ENABLED") == "1"
+	}
+	return levelRank(logLevel) >= levelRank(l.MinLevel)
 }
 
-func (l *Logger) sendWebhook(logLevel string, format string, v ...any) {
-	message := fmt.Sprintf(format, v...)
-	timestamp := time.Now().Format(time.RFC3339)
+func (l *Logger) now() time.Time {
+	if l.Now != nil {
+		return l.Now()
+	}
+	return time.Now()
+}
 
-	payload := struct {
-		ServiceName    string `json:"serviceName"`
-		LogContextName string `json:"logContextName"`
-		Message        string `json:"message"`
-		Level          string `json:"level"`
-		Timestamp      string `json:"timestamp"`
-	}{
-		ServiceName:    l.ServiceName,
-		LogContextName: l.LogContextName,
-		Message:        message,
-		Level:          logLevel,
-		Timestamp:      timestamp,
+func levelLabel(logLevel string) string {
+	if _, ok := levelRanks[logLevel]; ok {
+		return logLevel
 	}
+	return INFO
+}
 
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		l.Log(ERR, "Failed to marshal webhook payload: %v\n", err)
-		return
+func levelRank(logLevel string) int {
+	if rank, ok := levelRanks[logLevel]; ok {
+		return rank
+	}
+	return levelRanks[INFO]
+}
+
+func (l *Logger) newEntry(logLevel string, message string) Entry {
+	entry := Entry{
+		Level:   logLevel,
+		Time:    l.now(),
+		Service: l.ServiceName,
+		Context: l.LogContextName,
+		Message: message,
+	}
+	if len(l.Fields) > 0 || len(l.Enrichers) > 0 {
+		entry.Fields = make(Fields, len(l.Fields))
+		for k, val := range l.Fields {
+			entry.Fields[k] = val
+		}
+	}
+	for _, enrich := range l.Enrichers {
+		enrich(&entry)
 	}
+	return entry
+}
+
+func formatFields(fields Fields) string {
+	fields = flattenFields(fields)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 
-	resp, err := http.Post(l.WebhookConfig.Url, "application/json", bytes.NewBuffer(jsonPayload))
+	var sb strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(formatFieldValue(fields[k]))
+	}
+	return sb.String()
+}
+
+func encodeJSON(entry Entry) []byte {
+	return encodeJSONStack(entry, StackFormat{})
+}
+
+func encodeJSONStack(entry Entry, stack StackFormat) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	writeJSONField(&buf, "time", entry.Time.Format(time.RFC3339Nano))
+	buf.WriteByte(',')
+	writeJSONField(&buf, "level", entry.Level)
+	buf.WriteByte(',')
+	writeJSONField(&buf, "service", entry.Service)
+	if entry.Context != "" {
+		buf.WriteByte(',')
+		writeJSONField(&buf, "context", entry.Context)
+	}
+	buf.WriteByte(',')
+	writeJSONField(&buf, "message", strings.TrimRight(entry.Message, "\n"))
+	if entry.Caller != nil {
+		buf.WriteByte(',')
+		writeJSONField(&buf, "caller", entry.Caller.String())
+	}
+	if _, ok := entry.Fields["error"]; entry.Err != nil && !ok {
+		buf.WriteByte(',')
+		writeJSONField(&buf, "error", entry.Err.Error())
+	}
+	if frames := stack.json(entry.Stack); frames != nil {
+		buf.WriteByte(',')
+		writeJSONField(&buf, "stack", frames)
+	}
+
+	for _, k := range entryFieldKeys(entry) {
+		buf.WriteByte(',')
+		writeJSONField(&buf, k, entry.Fields[k])
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func entryFieldKeys(entry Entry) []string {
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		switch k {
+		case "time", "level", "service", "context", "message", "caller", "stack":
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeJSONField(buf *bytes.Buffer, key string, v any) {
+	keyJSON, _ := json.Marshal(key)
+	buf.Write(keyJSON)
+	buf.WriteByte(':')
+	buf.Write(marshalJSONValue(v))
+}
+
+func jsonFields(fields Fields) map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	safe := make(map[string]any, len(fields))
+	for k, v := range fields {
+		safe[k] = jsonValue(v)
+	}
+	return safe
+}
+
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case nil, string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return v
+	case Field:
+		return v
+	case error:
+		return v.Error()
+	case Fields:
+		return jsonFields(v)
+	}
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return v
+}
+
+func marshalJSONValue(v any) []byte {
+	if f, ok := v.(Field); ok {
+		return f.appendJSON(nil)
+	}
+	b, err := json.Marshal(jsonValue(v))
 	if err != nil {
-		l.Log(ERR, "Failed to send webhook: %v\n", err)
-		return
+		b, _ = json.Marshal(fmt.Sprint(v))
 	}
-	defer func(body io.ReadCloser) {
-		_ = body.Close()
-	}(resp.Body)
+	return b
+}
 
-	if resp.StatusCode != http.StatusOK {
-		l.Log(ERR, "Webhook responded with status: %s\n", resp.Status)
+func formatFieldValue(v any) string {
+	var s string
+	if f, ok := v.(Field); ok && f.kind != anyKind && f.kind != durationKind {
+		s = f.String()
+	} else if d, ok := fieldValue(v).(time.Duration); ok {
+		s = FormatDuration(d)
+	} else {
+		s = fmt.Sprint(v)
+	}
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
 	}
+	return s
+}
+
+func (l *Logger) LogInfo(format string, v ...any) {
+	l.Log(INFO, format, v...)
+}
+
+func (l *Logger) LogError(format string, v ...any) {
+	l.logError(l.WebhookConfig.sends(ERR), format, v...)
+}
+
+func (l *Logger) logError(sendWebhook bool, format string, v ...any) {
+	l.emit(ERR, format, v, sendWebhook, true)
+}
+
+func (l *Logger) LogFatal(format string, v ...any) {
+	l.emit(FATAL, format, v, l.WebhookConfig.sends(FATAL), true)
+	l.Flush()
+	os.Exit(1)
+}
+
+func (l *Logger) LogWarn(format string, v ...any) {
+	l.emit(WARN, format, v, l.WebhookConfig.sends(WARN), false)
+}
+
+func (l *Logger) Logln(logLevel string, v ...any) {
+	l.emitln(logLevel, v, false, false)
+}
+
+func (l *Logger) LogInfoln(v ...any) {
+	l.Logln(INFO, v...)
+}
+
+func (l *Logger) LogErrorln(v ...any) {
+	l.emitln(ERR, v, l.WebhookConfig.sends(ERR), true)
+}
+
+func (l *Logger) LogFatalln(v ...any) {
+	l.emitln(FATAL, v, l.WebhookConfig.sends(FATAL), true)
+	l.Flush()
+	os.Exit(1)
+}
+
+func (l *Logger) LogWarnln(v ...any) {
+	l.emitln(WARN, v, l.WebhookConfig.sends(WARN), false)
 }
@@ -0,0 +1,52 @@
+package loggertest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+const (
+	GoldenDir       = "testdata"
+	UpdateGoldenEnv = "UPDATE_GOLDEN"
+)
+
+var (
+	ansiPattern      = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+	timestampPattern = regexp.MustCompile(
+		`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})` +
+			`|\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}(\.\d+)?` +
+			`|\b\d{2}:\d{2}:\d{2}(\.\d+)?\b`,
+	)
+)
+
+func Normalize(output []byte) []byte {
+	output = ansiPattern.ReplaceAll(output, nil)
+	return timestampPattern.ReplaceAll(output, []byte("<time>"))
+}
+
+func AssertGolden(t testing.TB, name string, output []byte) {
+	t.Helper()
+	path := filepath.Join(GoldenDir, name+".golden")
+	got := Normalize(output)
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("failed to update golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s (run with %s=1 to create it): %v", path, UpdateGoldenEnv, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match golden file %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package loggertest
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gomessguii/logger"
+)
+
+type Recorder struct {
+	mu      sync.Mutex
+	entries []logger.Entry
+}
+
+func New(serviceName string) (*logger.Logger, *Recorder) {
+	recorder := &Recorder{}
+	return &logger.Logger{
+		ServiceName: serviceName,
+		MinLevel:    logger.DEBUG,
+		Output:      io.Discard,
+		Sinks:       []logger.Sink{recorder},
+	}, recorder
+}
+
+func (r *Recorder) Write(entry logger.Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *Recorder) Close() error {
+	return nil
+}
+
+func (r *Recorder) Entries() []logger.Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]logger.Entry(nil), r.entries...)
+}
+
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = nil
+}
+
+func (r *Recorder) Find(level string, substring string) []logger.Entry {
+	var found []logger.Entry
+	for _, entry := range r.Entries() {
+		if (level == "" || entry.Level == level) && strings.Contains(entry.Message, substring) {
+			found = append(found, entry)
+		}
+	}
+	return found
+}
+
+func (r *Recorder) AssertLogged(t testing.TB, level string, substring string) logger.Entry {
+	t.Helper()
+	found := r.Find(level, substring)
+	if len(found) == 0 {
+		t.Errorf("expected a %s entry containing %q, got:\n%s", level, substring, r.dump())
+		return logger.Entry{}
+	}
+	return found[0]
+}
+
+func (r *Recorder) AssertNotLogged(t testing.TB, level string, substring string) {
+	t.Helper()
+	if found := r.Find(level, substring); len(found) > 0 {
+		t.Errorf("unexpected %s entry containing %q: %s", level, substring, found[0].Message)
+	}
+}
+
+func (r *Recorder) AssertCount(t testing.TB, level string, want int) {
+	t.Helper()
+	if got := len(r.Find(level, "")); got != want {
+		t.Errorf("expected %d %s entries, got %d:\n%s", want, level, got, r.dump())
+	}
+}
+
+func (r *Recorder) dump() string {
+	entries := r.Entries()
+	if len(entries) == 0 {
+		return "  (no entries)"
+	}
+	var sb strings.Builder
+	for _, entry := range entries {
+		sb.WriteString("  [" + entry.Level + "] " + strings.TrimRight(entry.Message, "\n") + "\n")
+	}
+	return sb.String()
+}
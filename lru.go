@@ -0,0 +1,41 @@
+package logger
+
+import "container/list"
+
+type lru[V any] struct {
+	limit int
+	order *list.List
+	items map[string]*list.Element
+}
+
+type lruItem[V any] struct {
+	key   string
+	value V
+}
+
+func newLRU[V any](limit int) *lru[V] {
+	return &lru[V]{limit: limit, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (c *lru[V]) get(key string) (V, bool) {
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*lruItem[V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *lru[V]) put(key string, value V) {
+	if e, ok := c.items[key]; ok {
+		e.Value.(*lruItem[V]).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruItem[V]{key: key, value: value})
+	if c.order.Len() > c.limit {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem[V]).key)
+	}
+}
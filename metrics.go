@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type logMetrics struct {
+	mu      sync.Mutex
+	entries map[metricKey]uint64
+	dropped uint64
+}
+
+type metricKey struct {
+	level   string
+	context string
+}
+
+type EntryCount struct {
+	Level   string
+	Context string
+	Count   uint64
+}
+
+func (l *Logger) logMetrics() *logMetrics {
+	state := l.shared()
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if state.metrics == nil {
+		state.metrics = &logMetrics{entries: make(map[metricKey]uint64)}
+	}
+	return state.metrics
+}
+
+func (l *Logger) countEntry(entry Entry) {
+	m := l.logMetrics()
+	m.mu.Lock()
+	m.entries[metricKey{levelLabel(entry.Level), entry.Context}]++
+	m.mu.Unlock()
+}
+
+func (l *Logger) countDropped() {
+	m := l.logMetrics()
+	m.mu.Lock()
+	m.dropped++
+	m.mu.Unlock()
+}
+
+func (l *Logger) DroppedEntries() uint64 {
+	m := l.logMetrics()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dropped
+}
+
+func (l *Logger) EntryCounts() []EntryCount {
+	m := l.logMetrics()
+	m.mu.Lock()
+	counts := make([]EntryCount, 0, len(m.entries))
+	for key, n := range m.entries {
+		counts = append(counts, EntryCount{Level: key.level, Context: key.context, Count: n})
+	}
+	m.mu.Unlock()
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Level != counts[j].Level {
+			return levelRank(counts[i].Level) < levelRank(counts[j].Level)
+		}
+		return counts[i].Context < counts[j].Context
+	})
+	return counts
+}
+
+func (l *Logger) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var sb strings.Builder
+		service := promLabel(l.ServiceName)
+
+		sb.WriteString("# HELP logger_entries_total Log entries written, by level and context.\n")
+		sb.WriteString("# TYPE logger_entries_total counter\n")
+		for _, count := range l.EntryCounts() {
+			fmt.Fprintf(&sb, "logger_entries_total{service=%s,level=%s,context=%s} %d\n",
+				service, promLabel(count.Level), promLabel(count.Context), count.Count)
+		}
+
+		sb.WriteString("# HELP logger_entries_dropped_total Log entries dropped by sampling, filters or compliance checks.\n")
+		sb.WriteString("# TYPE logger_entries_dropped_total counter\n")
+		fmt.Fprintf(&sb, "logger_entries_dropped_total{service=%s} %d\n", service, l.DroppedEntries())
+
+		stats := l.WebhookStats()
+		sb.WriteString("# HELP logger_webhook_deliveries_total Webhook delivery outcomes.\n")
+		sb.WriteString("# TYPE logger_webhook_deliveries_total counter\n")
+		for _, outcome := range []struct {
+			name  string
+			count int
+		}{{"sent", stats.Sent}, {"failed", stats.Failed}, {"retried", stats.Retried}, {"dropped", stats.Dropped}} {
+			fmt.Fprintf(&sb, "logger_webhook_deliveries_total{service=%s,outcome=%q} %d\n", service, outcome.name, outcome.count)
+		}
+		sb.WriteString("# HELP logger_webhook_queue_depth Webhook jobs waiting for delivery.\n")
+		sb.WriteString("# TYPE logger_webhook_queue_depth gauge\n")
+		fmt.Fprintf(&sb, "logger_webhook_queue_depth{service=%s} %d\n", service, stats.QueueDepth)
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write([]byte(sb.String()))
+	})
+}
+
+var promLabelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func promLabel(value string) string {
+	return `"` + promLabelReplacer.Replace(value) + `"`
+}
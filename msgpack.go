@@ -0,0 +1,270 @@
+package logger
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+type MsgpackEncoder struct{}
+
+func (MsgpackEncoder) Encode(entry Entry) ([]byte, error) {
+	keys := entryFieldKeys(entry)
+	_, hasError := entry.Fields["error"]
+	n := 4 + len(keys)
+	if entry.Context != "" {
+		n++
+	}
+	if entry.Caller != nil {
+		n++
+	}
+	if entry.Err != nil && !hasError {
+		n++
+	}
+
+	b := make([]byte, 0, 128)
+	b = appendMsgpackMapHeader(b, n)
+	b = appendMsgpackString(b, "time")
+	b = appendMsgpackTimestamp(b, entry.Time)
+	b = appendMsgpackString(b, "level")
+	b = appendMsgpackString(b, entry.Level)
+	b = appendMsgpackString(b, "service")
+	b = appendMsgpackString(b, entry.Service)
+	if entry.Context != "" {
+		b = appendMsgpackString(b, "context")
+		b = appendMsgpackString(b, entry.Context)
+	}
+	b = appendMsgpackString(b, "message")
+	b = appendMsgpackString(b, strings.TrimRight(entry.Message, "\n"))
+	if entry.Caller != nil {
+		b = appendMsgpackString(b, "caller")
+		b = appendMsgpackString(b, entry.Caller.String())
+	}
+	if entry.Err != nil && !hasError {
+		b = appendMsgpackString(b, "error")
+		b = appendMsgpackString(b, entry.Err.Error())
+	}
+	for _, k := range keys {
+		b = appendMsgpackString(b, k)
+		b = appendMsgpack(b, entry.Fields[k])
+	}
+	return b, nil
+}
+
+func appendMsgpack(b []byte, v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(b, 0xc0)
+	case bool:
+		if v {
+			return append(b, 0xc3)
+		}
+		return append(b, 0xc2)
+	case int:
+		return appendMsgpackInt(b, int64(v))
+	case int8:
+		return appendMsgpackInt(b, int64(v))
+	case int16:
+		return appendMsgpackInt(b, int64(v))
+	case int32:
+		return appendMsgpackInt(b, int64(v))
+	case int64:
+		return appendMsgpackInt(b, v)
+	case uint:
+		return appendMsgpackUint(b, uint64(v))
+	case uint8:
+		return appendMsgpackUint(b, uint64(v))
+	case uint16:
+		return appendMsgpackUint(b, uint64(v))
+	case uint32:
+		return appendMsgpackUint(b, uint64(v))
+	case uint64:
+		return appendMsgpackUint(b, v)
+	case float32:
+		b = append(b, 0xca)
+		return binary.BigEndian.AppendUint32(b, math.Float32bits(v))
+	case float64:
+		b = append(b, 0xcb)
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(v))
+	case string:
+		return appendMsgpackString(b, v)
+	case []byte:
+		return appendMsgpackBinary(b, v)
+	case time.Duration:
+		return appendMsgpackInt(b, int64(v))
+	case ByteSize:
+		return appendMsgpackInt(b, int64(v))
+	case Field:
+		switch v.kind {
+		case stringKind:
+			return appendMsgpackString(b, v.str)
+		case intKind, durationKind:
+			return appendMsgpackInt(b, int64(v.num))
+		case uintKind:
+			return appendMsgpackUint(b, v.num)
+		case floatKind:
+			return binary.BigEndian.AppendUint64(append(b, 0xcb), v.num)
+		case boolKind:
+			return appendMsgpack(b, v.num == 1)
+		}
+		return appendMsgpack(b, v.iface)
+	case Count:
+		return appendMsgpackInt(b, int64(v))
+	case time.Time:
+		return appendMsgpackString(b, v.Format(time.RFC3339Nano))
+	case error:
+		return appendMsgpackString(b, v.Error())
+	case fmt.Stringer:
+		return appendMsgpackString(b, v.String())
+	case Fields:
+		return appendMsgpackMap(b, v)
+	case map[string]any:
+		return appendMsgpackMap(b, v)
+	case []any:
+		b = appendMsgpackArrayHeader(b, len(v))
+		for _, item := range v {
+			b = appendMsgpack(b, item)
+		}
+		return b
+	case []string:
+		b = appendMsgpackArrayHeader(b, len(v))
+		for _, item := range v {
+			b = appendMsgpackString(b, item)
+		}
+		return b
+	}
+
+	var generic any
+	data, err := json.Marshal(v)
+	if err == nil && json.Unmarshal(data, &generic) == nil {
+		return appendMsgpack(b, generic)
+	}
+	return appendMsgpackString(b, fmt.Sprint(v))
+}
+
+func appendMsgpackInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0:
+		return appendMsgpackUint(b, uint64(v))
+	case v >= -32:
+		return append(b, byte(v))
+	case v >= math.MinInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16:
+		b = append(b, 0xd1)
+		return binary.BigEndian.AppendUint16(b, uint16(v))
+	case v >= math.MinInt32:
+		b = append(b, 0xd2)
+		return binary.BigEndian.AppendUint32(b, uint32(v))
+	default:
+		b = append(b, 0xd3)
+		return binary.BigEndian.AppendUint64(b, uint64(v))
+	}
+}
+
+func appendMsgpackUint(b []byte, v uint64) []byte {
+	switch {
+	case v <= 0x7f:
+		return append(b, byte(v))
+	case v <= math.MaxUint8:
+		return append(b, 0xcc, byte(v))
+	case v <= math.MaxUint16:
+		b = append(b, 0xcd)
+		return binary.BigEndian.AppendUint16(b, uint16(v))
+	case v <= math.MaxUint32:
+		b = append(b, 0xce)
+		return binary.BigEndian.AppendUint32(b, uint32(v))
+	default:
+		b = append(b, 0xcf)
+		return binary.BigEndian.AppendUint64(b, v)
+	}
+}
+
+func appendMsgpackString(b []byte, s string) []byte {
+	n := len(s)
+	switch {
+	case n <= 31:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, 0xda)
+		b = binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		b = append(b, 0xdb)
+		b = binary.BigEndian.AppendUint32(b, uint32(n))
+	}
+	return append(b, s...)
+}
+
+func appendMsgpackBinary(b []byte, data []byte) []byte {
+	n := len(data)
+	switch {
+	case n <= math.MaxUint8:
+		b = append(b, 0xc4, byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, 0xc5)
+		b = binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		b = append(b, 0xc6)
+		b = binary.BigEndian.AppendUint32(b, uint32(n))
+	}
+	return append(b, data...)
+}
+
+func appendMsgpackArrayHeader(b []byte, n int) []byte {
+	switch {
+	case n <= 15:
+		return append(b, 0x90|byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, 0xdc)
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		b = append(b, 0xdd)
+		return binary.BigEndian.AppendUint32(b, uint32(n))
+	}
+}
+
+func appendMsgpackMapHeader(b []byte, n int) []byte {
+	switch {
+	case n <= 15:
+		return append(b, 0x80|byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, 0xde)
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		b = append(b, 0xdf)
+		return binary.BigEndian.AppendUint32(b, uint32(n))
+	}
+}
+
+func appendMsgpackMap(b []byte, m map[string]any) []byte {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b = appendMsgpackMapHeader(b, len(keys))
+	for _, k := range keys {
+		b = appendMsgpackString(b, k)
+		b = appendMsgpack(b, m[k])
+	}
+	return b
+}
+
+func appendMsgpackEventTime(b []byte, t time.Time) []byte {
+	b = append(b, 0xd7, 0x00)
+	b = binary.BigEndian.AppendUint32(b, uint32(t.Unix()))
+	return binary.BigEndian.AppendUint32(b, uint32(t.Nanosecond()))
+}
+
+func appendMsgpackTimestamp(b []byte, t time.Time) []byte {
+	b = append(b, 0xc7, 12, 0xff)
+	b = binary.BigEndian.AppendUint32(b, uint32(t.Nanosecond()))
+	return binary.BigEndian.AppendUint64(b, uint64(t.Unix()))
+}
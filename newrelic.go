@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	NewRelicRegionUS = "US"
+	NewRelicRegionEU = "EU"
+)
+
+var newRelicAttributeAliases = map[string]string{
+	"trace_id": "trace.id",
+	"traceId":  "trace.id",
+	"span_id":  "span.id",
+	"spanId":   "span.id",
+}
+
+type NewRelicSink struct {
+	LicenseKey     string
+	Region         string
+	BatchSize      int
+	FlushInterval  time.Duration
+	Compress       bool
+	HTTPClient     *http.Client
+	ErrorHandler   func(err error)
+	OverflowPolicy string
+
+	once    sync.Once
+	batcher *entryBatcher
+}
+
+func (n *NewRelicSink) Write(entry Entry) error {
+	return n.batch().add(entry)
+}
+
+func (n *NewRelicSink) Close() error {
+	return n.batch().close()
+}
+
+func (n *NewRelicSink) Dropped() uint64 {
+	return n.batch().dropped.Load()
+}
+
+func (n *NewRelicSink) batch() *entryBatcher {
+	n.once.Do(func() {
+		n.batcher = newEntryBatcher(n.BatchSize, n.FlushInterval, n.OverflowPolicy, n.send, n.ErrorHandler)
+	})
+	return n.batcher
+}
+
+func (n *NewRelicSink) send(entries []Entry) error {
+	logs := make([]map[string]any, 0, len(entries))
+	for _, entry := range entries {
+		attributes := map[string]any{
+			"service.name": entry.Service,
+			"entity.name":  entry.Service,
+		}
+		if entry.Context != "" {
+			attributes["logger.name"] = entry.Context
+		}
+		for k, v := range jsonFields(entry.Fields) {
+			if alias, ok := newRelicAttributeAliases[k]; ok {
+				k = alias
+			}
+			attributes[k] = v
+		}
+		logs = append(logs, map[string]any{
+			"timestamp":  entry.Time.UnixMilli(),
+			"message":    strings.TrimRight(entry.Message, "\n"),
+			"level":      SeverityName(entry.Level),
+			"attributes": attributes,
+		})
+	}
+
+	body, err := json.Marshal([]map[string]any{{
+		"common": map[string]any{
+			"attributes": map[string]any{"hostname": hostname()},
+		},
+		"logs": logs,
+	}})
+	if err != nil {
+		return err
+	}
+
+	endpoint := "https://log-api.newrelic.com/log/v1"
+	if strings.EqualFold(n.Region, NewRelicRegionEU) {
+		endpoint = "https://log-api.eu.newrelic.com/log/v1"
+	}
+	headers := map[string]string{"X-License-Key": n.LicenseKey}
+	return postBody(context.Background(), n.HTTPClient, endpoint, "application/json", headers, body, n.Compress)
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+type Notifier interface {
+	Levels() []string
+	Notify(ctx context.Context, entry Entry) error
+}
+
+var defaultNotifyLevels = []string{WARN, ERR, FATAL}
+
+func notifyLevels(levels []string) []string {
+	if len(levels) == 0 {
+		return defaultNotifyLevels
+	}
+	return levels
+}
+
+func (l *Logger) notify(entry Entry) {
+	for _, n := range l.Notifiers {
+		if !slices.Contains(n.Levels(), entry.Level) {
+			continue
+		}
+		if entry.Stack == nil && levelRank(entry.Level) >= levelRank(ERR) {
+			entry.Stack = captureStack()
+		}
+		l.webhooks().enqueue(webhookJob{notifier: n, entry: entry})
+	}
+}
+
+type retryAfterError struct {
+	err        error
+	after      time.Duration
+	maxRetries int
+}
+
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+func (d *webhookDispatcher) notify(job webhookJob) {
+	err := job.notifier.Notify(d.context(), job.entry)
+	if err == nil {
+		return
+	}
+	var retry *retryAfterError
+	if errors.As(err, &retry) && job.attempt < retry.maxRetries {
+		job.attempt++
+		d.retryLater(job, retry.after)
+		return
+	}
+	d.logger.logInternal("Failed to send notification: %v\n", err)
+}
+
+func (d *webhookDispatcher) retryLater(job webhookJob, after time.Duration) {
+	d.mu.Lock()
+	d.pending++
+	d.mu.Unlock()
+	time.AfterFunc(after, func() {
+		offer(d.lane(job), job, d.policy, d.drop)
+	})
+}
@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+const maxOnceKeys = 10000
+
+var (
+	onceMu   sync.Mutex
+	onceSeen = newLRU[time.Time](maxOnceKeys)
+)
+
+func (l *Logger) LogOnce(logLevel string, key string, format string, v ...any) {
+	l.LogOnceEvery(logLevel, key, 0, format, v...)
+}
+
+func (l *Logger) LogOnceEvery(logLevel string, key string, interval time.Duration, format string, v ...any) {
+	if !l.firstTime(key, interval) {
+		return
+	}
+	l.emit(logLevel, format, v, l.WebhookConfig.sends(logLevel), levelRank(logLevel) >= levelRank(ERR))
+}
+
+func (l *Logger) WarnOnce(key string, format string, v ...any) {
+	l.LogOnce(WARN, key, format, v...)
+}
+
+func (l *Logger) InfoOnce(key string, format string, v ...any) {
+	l.LogOnce(INFO, key, format, v...)
+}
+
+func (l *Logger) firstTime(key string, interval time.Duration) bool {
+	now := l.now()
+	onceMu.Lock()
+	defer onceMu.Unlock()
+	last, seen := onceSeen.get(key)
+	if seen && (interval <= 0 || now.Sub(last) < interval) {
+		return false
+	}
+	onceSeen.put(key, now)
+	return true
+}
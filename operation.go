@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+type Operation struct {
+	logger *Logger
+	name   string
+	start  time.Time
+	once   sync.Once
+}
+
+func (l *Logger) Begin(name string) *Operation {
+	l.LogInfo("%s started", name)
+	return &Operation{
+		logger: l,
+		name:   name,
+		start:  l.now(),
+	}
+}
+
+func (op *Operation) Success() {
+	op.once.Do(func() {
+		op.logger.LogInfo("%s succeeded in %s", op.name, op.logger.now().Sub(op.start))
+	})
+}
+
+func (op *Operation) Fail(err error) {
+	op.once.Do(func() {
+		op.logger.logError(len(op.logger.WebhookConfig.urlsFor(ERR)) > 0, "%s failed after %s: %v", op.name, op.logger.now().Sub(op.start), err)
+	})
+}
+
+func (op *Operation) End(err error) {
+	if err != nil {
+		op.Fail(err)
+		return
+	}
+	op.Success()
+}
@@ -0,0 +1,85 @@
+//go:build darwin && cgo
+
+package logger
+
+/*
+#include <os/log.h>
+#include <stdlib.h>
+
+static os_log_t logger_os_log_create(const char *subsystem, const char *category) {
+	return os_log_create(subsystem, category);
+}
+
+static void logger_os_log(os_log_t log, uint8_t type, const char *message) {
+	os_log_with_type(log, (os_log_type_t)type, "%{public}s", message);
+}
+*/
+import "C"
+
+import (
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+type OSLogSink struct {
+	Subsystem string
+
+	mu   sync.Mutex
+	logs map[[2]string]C.os_log_t
+}
+
+func (s *OSLogSink) Levels() []string {
+	return AllLevels
+}
+
+func (s *OSLogSink) Fire(entry Entry) error {
+	return s.Write(entry)
+}
+
+func (s *OSLogSink) Write(entry Entry) error {
+	subsystem := s.Subsystem
+	if subsystem == "" {
+		subsystem = entry.Service
+	}
+	category := entry.Context
+	if category == "" {
+		category = "default"
+	}
+
+	message := strings.TrimRight(entry.Message, "\n")
+	if len(entry.Fields) > 0 {
+		message += " " + formatFields(entry.Fields)
+	}
+	cMessage := C.CString(message)
+	defer C.free(unsafe.Pointer(cMessage))
+	C.logger_os_log(s.log(subsystem, category), C.uint8_t(osLogType(entry.Level)), cMessage)
+	return nil
+}
+
+func (s *OSLogSink) Close() error {
+	return nil
+}
+
+func (s *OSLogSink) log(subsystem string, category string) C.os_log_t {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := [2]string{subsystem, category}
+	if log, ok := s.logs[key]; ok {
+		return log
+	}
+	if s.logs == nil {
+		s.logs = make(map[[2]string]C.os_log_t)
+	}
+	cSubsystem := C.CString(subsystem)
+	defer C.free(unsafe.Pointer(cSubsystem))
+	cCategory := C.CString(category)
+	defer C.free(unsafe.Pointer(cCategory))
+	log := C.logger_os_log_create(cSubsystem, cCategory)
+	s.logs[key] = log
+	return log
+}
+
+func osLogType(logLevel string) uint8 {
+	return syslogScale[uint8](logLevel, C.OS_LOG_TYPE_FAULT, C.OS_LOG_TYPE_ERROR, C.OS_LOG_TYPE_DEFAULT, C.OS_LOG_TYPE_INFO, C.OS_LOG_TYPE_DEBUG)
+}
@@ -0,0 +1,23 @@
+//go:build !darwin || !cgo
+
+package logger
+
+type OSLogSink struct {
+	Subsystem string
+}
+
+func (s *OSLogSink) Levels() []string {
+	return AllLevels
+}
+
+func (s *OSLogSink) Fire(entry Entry) error {
+	return s.Write(entry)
+}
+
+func (s *OSLogSink) Write(entry Entry) error {
+	return ErrOSLogUnsupported
+}
+
+func (s *OSLogSink) Close() error {
+	return nil
+}
@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+const meterName = "github.com/gomessguii/logger"
+
+type MeterProvider interface {
+	Meter(name string) Meter
+}
+
+type Meter interface {
+	Int64Counter(name string, description string, unit string) (Int64Counter, error)
+	Float64Histogram(name string, description string, unit string) (Float64Histogram, error)
+	Int64ObservableGauge(name string, description string, unit string, observe func() int64) error
+}
+
+type Int64Counter interface {
+	Add(ctx context.Context, incr int64, attributes map[string]string)
+}
+
+type Float64Histogram interface {
+	Record(ctx context.Context, value float64, attributes map[string]string)
+}
+
+type loggerInstruments struct {
+	entries Int64Counter
+	latency Float64Histogram
+}
+
+func (l *Logger) instruments() *loggerInstruments {
+	if l.MeterProvider == nil {
+		return nil
+	}
+	state := l.shared()
+	state.instrumentsOnce.Do(func() {
+		meter := l.MeterProvider.Meter(meterName)
+		entries, entriesErr := meter.Int64Counter("logger.entries", "Log entries written, by severity.", "{entry}")
+		latency, latencyErr := meter.Float64Histogram("logger.webhook.latency", "Webhook delivery attempt latency.", "s")
+		gaugeErr := meter.Int64ObservableGauge("logger.webhook.queue_depth", "Webhook jobs waiting for delivery.", "{job}", func() int64 {
+			return int64(l.WebhookStats().QueueDepth)
+		})
+		if err := errors.Join(entriesErr, latencyErr, gaugeErr); err != nil {
+			l.logInternal("Failed to create logger metrics: %v\n", err)
+		}
+		state.instruments = &loggerInstruments{entries: entries, latency: latency}
+	})
+	return state.instruments
+}
+
+func (l *Logger) recordEntryMetric(entry Entry) {
+	if instruments := l.instruments(); instruments != nil && instruments.entries != nil {
+		instruments.entries.Add(context.Background(), 1, map[string]string{
+			"severity": levelLabel(entry.Level),
+			"service":  entry.Service,
+		})
+	}
+}
+
+func (l *Logger) recordWebhookLatency(target string, elapsed time.Duration, err error) {
+	if instruments := l.instruments(); instruments != nil && instruments.latency != nil {
+		outcome := "success"
+		if err != nil {
+			outcome = "failure"
+		}
+		instruments.latency.Record(context.Background(), elapsed.Seconds(), map[string]string{
+			"host":    metricHost(target),
+			"outcome": outcome,
+		})
+	}
+}
+
+func metricHost(target string) string {
+	u, err := url.Parse(target)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Hostname()
+}
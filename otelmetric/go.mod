@@ -0,0 +1,9 @@
+module github.com/gomessguii/logger/otelmetric
+
+go 1.22.2
+
+require (
+	github.com/gomessguii/logger v0.0.0-20261016090253-5047f2913605
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/metric v1.28.0
+)
@@ -0,0 +1,82 @@
+// Package otelmetric adapts an OpenTelemetry metric.MeterProvider to the
+// logger.MeterProvider interface, so logger metrics can be exported through
+// any OpenTelemetry SDK:
+//
+//	log.MeterProvider = otelmetric.New(otel.GetMeterProvider())
+package otelmetric
+
+import (
+	"context"
+
+	"github.com/gomessguii/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func New(provider metric.MeterProvider) logger.MeterProvider {
+	return meterProvider{provider: provider}
+}
+
+type meterProvider struct {
+	provider metric.MeterProvider
+}
+
+func (p meterProvider) Meter(name string) logger.Meter {
+	return meter{meter: p.provider.Meter(name)}
+}
+
+type meter struct {
+	meter metric.Meter
+}
+
+func (m meter) Int64Counter(name string, description string, unit string) (logger.Int64Counter, error) {
+	counter, err := m.meter.Int64Counter(name, metric.WithDescription(description), metric.WithUnit(unit))
+	if err != nil {
+		return nil, err
+	}
+	return int64Counter{counter: counter}, nil
+}
+
+func (m meter) Float64Histogram(name string, description string, unit string) (logger.Float64Histogram, error) {
+	histogram, err := m.meter.Float64Histogram(name, metric.WithDescription(description), metric.WithUnit(unit))
+	if err != nil {
+		return nil, err
+	}
+	return float64Histogram{histogram: histogram}, nil
+}
+
+func (m meter) Int64ObservableGauge(name string, description string, unit string, observe func() int64) error {
+	_, err := m.meter.Int64ObservableGauge(name,
+		metric.WithDescription(description),
+		metric.WithUnit(unit),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			observer.Observe(observe())
+			return nil
+		}),
+	)
+	return err
+}
+
+type int64Counter struct {
+	counter metric.Int64Counter
+}
+
+func (c int64Counter) Add(ctx context.Context, incr int64, attributes map[string]string) {
+	c.counter.Add(ctx, incr, metric.WithAttributes(keyValues(attributes)...))
+}
+
+type float64Histogram struct {
+	histogram metric.Float64Histogram
+}
+
+func (h float64Histogram) Record(ctx context.Context, value float64, attributes map[string]string) {
+	h.histogram.Record(ctx, value, metric.WithAttributes(keyValues(attributes)...))
+}
+
+func keyValues(attributes map[string]string) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		kvs = append(kvs, attribute.String(key, value))
+	}
+	return kvs
+}
@@ -0,0 +1,40 @@
+package logger
+
+import "errors"
+
+const (
+	OverflowDropNewest = "drop-newest"
+	OverflowDropOldest = "drop-oldest"
+	OverflowBlock      = "block"
+)
+
+var ErrQueueFull = errors.New("queue is full, dropping entry")
+
+func offer[T any](queue chan T, item T, policy string, drop func(T)) bool {
+	switch policy {
+	case OverflowBlock:
+		queue <- item
+		return true
+	case OverflowDropOldest:
+		for {
+			select {
+			case queue <- item:
+				return true
+			default:
+			}
+			select {
+			case oldest := <-queue:
+				drop(oldest)
+			default:
+			}
+		}
+	default:
+		select {
+		case queue <- item:
+			return true
+		default:
+			drop(item)
+			return false
+		}
+	}
+}
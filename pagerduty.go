@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	pagerDutyEventsURL  = "https://events.pagerduty.com/v2/enqueue"
+	pagerDutyMaxSummary = 1024
+)
+
+type PagerDutyNotifier struct {
+	RoutingKey    string
+	IncludeErrors bool
+	HTTPClient    *http.Client
+	EventsURL     string
+}
+
+func (p *PagerDutyNotifier) Levels() []string {
+	if p.IncludeErrors {
+		return []string{ERR, FATAL}
+	}
+	return []string{FATAL}
+}
+
+func (p *PagerDutyNotifier) Notify(ctx context.Context, entry Entry) error {
+	summary := strings.TrimRight(entry.Message, "\n")
+	if len(summary) > pagerDutyMaxSummary {
+		summary = summary[:pagerDutyMaxSummary]
+	}
+	severity := syslogScale(entry.Level, "critical", "error", "warning", "info", "info")
+
+	payload := map[string]any{
+		"summary":   summary,
+		"source":    entry.Service,
+		"severity":  severity,
+		"timestamp": entry.Time.Format(time.RFC3339),
+	}
+	if entry.Context != "" {
+		payload["component"] = entry.Context
+	}
+	if len(entry.Fields) > 0 {
+		payload["custom_details"] = jsonFields(entry.Fields)
+	}
+
+	eventsURL := p.EventsURL
+	if eventsURL == "" {
+		eventsURL = pagerDutyEventsURL
+	}
+	return postJSON(ctx, p.HTTPClient, eventsURL, nil, map[string]any{
+		"routing_key":  p.RoutingKey,
+		"event_action": "trigger",
+		"dedup_key":    PagerDutyDedupKey(entry),
+		"payload":      payload,
+	})
+}
+
+func PagerDutyDedupKey(entry Entry) string {
+	sum := sha256.Sum256([]byte(entry.Service + "\x00" + entry.Context + "\x00" + strings.TrimRight(entry.Message, "\n")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const PanicField = "panic"
+
+func HandlePanics(l *Logger) {
+	if r := recover(); r != nil {
+		l.logPanic(FATAL, r, nil)
+		l.Flush()
+		panic(r)
+	}
+}
+
+func (l *Logger) Go(fn func()) {
+	go func() {
+		defer HandlePanics(l)
+		fn()
+	}()
+}
+
+func (l *Logger) RecoverMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+			l.logPanic(ERR, rec, Fields{"method": r.Method, "url": r.URL.Redacted()})
+			w.WriteHeader(http.StatusInternalServerError)
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *Logger) logPanic(logLevel string, r any, fields Fields) {
+	stack := captureStack()
+	for len(stack) > 1 && strings.HasPrefix(stack[0].Function, "runtime.") {
+		stack = stack[1:]
+	}
+
+	child := l.withRootFields(Fields{PanicField: fmt.Sprint(r)}).WithFields(fields)
+	child.Enrichers = append(slices.Clip(child.Enrichers), func(e *Entry) {
+		e.Stack = stack
+	})
+	child.emit(logLevel, "panic: %v", []any{r}, len(l.WebhookConfig.urlsFor(logLevel)) > 0, true)
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func ParseJSONEntry(line []byte) (Entry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	var record map[string]any
+	if err := decoder.Decode(&record); err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{}
+	for k, v := range record {
+		s, isString := v.(string)
+		switch {
+		case k == "time" && isString:
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return Entry{}, err
+			}
+			entry.Time = t
+		case k == "level" && isString:
+			entry.Level = s
+		case k == "service" && isString:
+			entry.Service = s
+		case k == "context" && isString:
+			entry.Context = s
+		case k == "message" && isString:
+			entry.Message = s
+		case k == "caller" && isString:
+			entry.Caller = parseCaller(s)
+		default:
+			if entry.Fields == nil {
+				entry.Fields = Fields{}
+			}
+			entry.Fields[k] = v
+		}
+	}
+	if entry.Level == "" && entry.Message == "" {
+		return Entry{}, errors.New("line is not a log entry")
+	}
+	return entry, nil
+}
+
+func parseCaller(s string) *Frame {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return &Frame{File: s}
+	}
+	line, _ := strconv.Atoi(s[i+1:])
+	return &Frame{File: s[:i], Line: line}
+}
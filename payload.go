@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+)
+
+type PayloadBuilder interface {
+	Build(entry Entry) (contentType string, body []byte, err error)
+}
+
+type PayloadBuilderFunc func(entry Entry) (string, []byte, error)
+
+func (f PayloadBuilderFunc) Build(entry Entry) (string, []byte, error) {
+	return f(entry)
+}
+
+type JSONPayload struct {
+	IncludeFields   bool
+	IncludeHostname bool
+	IncludeStack    bool
+	IncludeSeverity bool
+}
+
+func (p JSONPayload) Build(entry Entry) (string, []byte, error) {
+	payload := struct {
+		ServiceName    string         `json:"serviceName"`
+		LogContextName string         `json:"logContextName"`
+		Message        string         `json:"message"`
+		Level          string         `json:"level"`
+		Timestamp      string         `json:"timestamp"`
+		Severity       *int           `json:"severity,omitempty"`
+		Hostname       string         `json:"hostname,omitempty"`
+		Fields         map[string]any `json:"fields,omitempty"`
+		Stack          []Frame        `json:"stack,omitempty"`
+	}{
+		ServiceName:    entry.Service,
+		LogContextName: entry.Context,
+		Message:        entry.Message,
+		Level:          entry.Level,
+		Timestamp:      entry.Time.Format(time.RFC3339),
+	}
+	if p.IncludeSeverity {
+		severity := OTelSeverity(entry.Level)
+		payload.Severity = &severity
+	}
+	if p.IncludeHostname {
+		payload.Hostname = hostname()
+	}
+	if p.IncludeFields {
+		payload.Fields = jsonFields(entry.Fields)
+	}
+	if p.IncludeStack {
+		payload.Stack = entry.Stack
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	return "application/json", jsonPayload, nil
+}
+
+func truncateText(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:limit-1]) + "…"
+}
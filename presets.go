@@ -0,0 +1,28 @@
+package logger
+
+import "time"
+
+func NewDevelopment(serviceName string) *Logger {
+	return &Logger{
+		ServiceName: serviceName,
+		MinLevel:    DEBUG,
+		Format:      FormatText,
+	}
+}
+
+func NewProduction(serviceName string) *Logger {
+	return &Logger{
+		ServiceName: serviceName,
+		MinLevel:    INFO,
+		Format:      FormatJSON,
+		Sampling: &Sampling{
+			Tick:       time.Second,
+			First:      100,
+			Thereafter: 100,
+		},
+	}
+}
+
+func Nop() *Logger {
+	return &Logger{nop: true}
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const prettyTimeFormat = "15:04:05.000"
+
+func encodePretty(entry Entry, colors ColorScheme, colored bool, stack StackFormat, levels LevelFormat) []byte {
+	dim := ""
+	if colored {
+		dim = colorDim
+	}
+
+	timestamp := entry.Time.Format(prettyTimeFormat)
+	service := fmt.Sprintf("[%s]", entry.Service)
+	levels.FixedWidth = true
+	label := levels.render(colors, entry.Level, false)
+
+	var sb strings.Builder
+	sb.WriteString(colorize(dim, timestamp))
+	sb.WriteByte(' ')
+	sb.WriteString(colorize(colors.Service, service))
+	sb.WriteByte(' ')
+	sb.WriteString(label)
+	sb.WriteByte(' ')
+	indent := strings.Repeat(" ", len(timestamp)+len(service)+displayWidth(levels.render(ColorScheme{}, entry.Level, false))+3)
+
+	lines := strings.Split(strings.TrimRight(entry.Message, "\n"), "\n")
+	for i, line := range lines {
+		if i > 0 {
+			sb.WriteByte('\n')
+			sb.WriteString(indent)
+		}
+		sb.WriteString(line)
+	}
+
+	if len(entry.Fields) > 0 {
+		fields := flattenFields(entry.Fields)
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		sb.WriteString("  ")
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(colorize(dim, k+"="+formatFieldValue(fields[k])))
+		}
+	}
+	if entry.Caller != nil {
+		sb.WriteString("  ")
+		sb.WriteString(colorize(dim, entry.Caller.String()))
+	}
+	frames := stack.frames(entry.Stack)
+	switch {
+	case len(frames) == 0:
+	case stack.Style == StackCompact:
+		sb.WriteString("  ")
+		sb.WriteString(colorize(dim, "stack="+strings.Join(stack.compact(frames), " < ")))
+	default:
+		for _, line := range strings.Split(formatStack(frames), "\n") {
+			sb.WriteByte('\n')
+			sb.WriteString(indent)
+			sb.WriteString(colorize(dim, line))
+		}
+	}
+	sb.WriteByte('\n')
+	return []byte(sb.String())
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+type Progress struct {
+	Label string
+	Step  int
+	Level string
+
+	logger *Logger
+	total  int
+	start  time.Time
+	mu     sync.Mutex
+	done   int
+	logged int
+}
+
+func (l *Logger) Progress(total int) *Progress {
+	return &Progress{
+		Label:  "progress",
+		Step:   10,
+		Level:  INFO,
+		logger: l,
+		total:  total,
+		start:  l.now(),
+	}
+}
+
+func (p *Progress) Increment() {
+	p.Add(1)
+}
+
+func (p *Progress) Add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.total <= 0 {
+		return
+	}
+	p.done = min(p.done+n, p.total)
+
+	step := p.Step
+	if step <= 0 || step > 100 {
+		step = 10
+	}
+	percent := p.done * 100 / p.total
+	milestone := percent / step * step
+	if p.done == p.total {
+		milestone = 100
+	}
+	if milestone <= p.logged {
+		return
+	}
+	p.logged = milestone
+	p.logger.Log(p.Level, "%s: %d%% (%d/%d) in %s", p.Label, milestone, p.done, p.total, p.logger.now().Sub(p.start).Round(time.Millisecond))
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"encoding/binary"
+	"sort"
+	"strings"
+)
+
+const (
+	protoVarint = 0
+	protoBytes  = 2
+)
+
+type ProtobufEncoder struct{}
+
+func (ProtobufEncoder) Encode(entry Entry) ([]byte, error) {
+	return appendProtoEntry(make([]byte, 0, 128), entry), nil
+}
+
+func appendProtoEntry(b []byte, entry Entry) []byte {
+	if !entry.Time.IsZero() {
+		b = appendProtoTag(b, 1, protoVarint)
+		b = binary.AppendUvarint(b, uint64(entry.Time.UnixNano()))
+	}
+	b = appendProtoString(b, 2, entry.Level)
+	b = appendProtoString(b, 3, entry.Service)
+	b = appendProtoString(b, 4, entry.Context)
+	b = appendProtoString(b, 5, strings.TrimRight(entry.Message, "\n"))
+
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var item []byte
+		item = appendProtoString(item, 1, k)
+		item = appendProtoString(item, 2, string(marshalJSONValue(entry.Fields[k])))
+		b = appendProtoMessage(b, 6, item)
+	}
+
+	if entry.Caller != nil {
+		b = appendProtoString(b, 7, entry.Caller.String())
+	}
+	if entry.Err != nil {
+		b = appendProtoString(b, 8, entry.Err.Error())
+	}
+	for _, frame := range entry.Stack {
+		var item []byte
+		item = appendProtoString(item, 1, frame.Function)
+		item = appendProtoString(item, 2, frame.File)
+		if frame.Line != 0 {
+			item = appendProtoTag(item, 3, protoVarint)
+			item = binary.AppendUvarint(item, uint64(frame.Line))
+		}
+		b = appendProtoMessage(b, 9, item)
+	}
+	if severity := OTelSeverity(entry.Level); severity != 0 {
+		b = appendProtoTag(b, 10, protoVarint)
+		b = binary.AppendUvarint(b, uint64(severity))
+	}
+	return b
+}
+
+func appendProtoTag(b []byte, field int, wireType int) []byte {
+	return binary.AppendUvarint(b, uint64(field)<<3|uint64(wireType))
+}
+
+func appendProtoString(b []byte, field int, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = appendProtoTag(b, field, protoBytes)
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendProtoMessage(b []byte, field int, message []byte) []byte {
+	b = appendProtoTag(b, field, protoBytes)
+	b = binary.AppendUvarint(b, uint64(len(message)))
+	return append(b, message...)
+}
@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+func Replay(r io.Reader, sinks ...Sink) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+
+	var errs []error
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		entry, err := ParseJSONEntry(scanner.Bytes())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+		for _, sink := range sinks {
+			if err := sink.Write(entry); err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+const defaultRingBufferSize = 1000
+
+type RingBuffer struct {
+	Size int
+
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+func (r *RingBuffer) Write(entry Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.entries == nil {
+		size := r.Size
+		if size <= 0 {
+			size = defaultRingBufferSize
+		}
+		r.entries = make([]Entry, size)
+	}
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+	return nil
+}
+
+func (r *RingBuffer) Close() error {
+	return nil
+}
+
+func (r *RingBuffer) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]Entry(nil), r.entries[:r.next]...)
+	}
+	entries := make([]Entry, 0, len(r.entries))
+	entries = append(entries, r.entries[r.next:]...)
+	return append(entries, r.entries[:r.next]...)
+}
+
+func (r *RingBuffer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	query := req.URL.Query()
+	minLevel := query.Get("level")
+	context := query.Get("context")
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	matched := make([]Entry, 0)
+	for _, entry := range r.Entries() {
+		if minLevel != "" && levelRank(entry.Level) < levelRank(minLevel) {
+			continue
+		}
+		if context != "" && entry.Context != context {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	if limit > 0 && len(matched) > limit {
+		matched = matched[len(matched)-limit:]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, entry := range matched {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(bytes.TrimRight(encodeJSON(entry), "\n"))
+	}
+	buf.WriteString("]\n")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const rollbarEndpoint = "https://api.rollbar.com/api/1/item/"
+
+type RollbarNotifier struct {
+	AccessToken  string
+	Environment  string
+	CodeVersion  string
+	NotifyLevels []string
+	HTTPClient   *http.Client
+	Endpoint     string
+}
+
+func (r *RollbarNotifier) Levels() []string {
+	if len(r.NotifyLevels) == 0 {
+		return []string{ERR, FATAL}
+	}
+	return r.NotifyLevels
+}
+
+func (r *RollbarNotifier) Notify(ctx context.Context, entry Entry) error {
+	message := strings.TrimRight(entry.Message, "\n")
+
+	var body map[string]any
+	if len(entry.Stack) > 0 {
+		frames := make([]map[string]any, 0, len(entry.Stack))
+		for i := len(entry.Stack) - 1; i >= 0; i-- {
+			frame := entry.Stack[i]
+			frames = append(frames, map[string]any{
+				"filename": frame.File,
+				"lineno":   frame.Line,
+				"method":   frame.Function,
+			})
+		}
+		body = map[string]any{"trace": map[string]any{
+			"frames": frames,
+			"exception": map[string]string{
+				"class":   firstLine(message),
+				"message": message,
+			},
+		}}
+	} else {
+		body = map[string]any{"message": map[string]string{"body": message}}
+	}
+
+	custom := jsonFields(entry.Fields)
+	if custom == nil {
+		custom = map[string]any{}
+	}
+	custom["service"] = entry.Service
+
+	environment := r.Environment
+	if environment == "" {
+		environment = "production"
+	}
+	data := map[string]any{
+		"environment": environment,
+		"level":       syslogScale(entry.Level, "critical", "error", "warning", "info", "debug"),
+		"timestamp":   entry.Time.Unix(),
+		"platform":    "go",
+		"language":    "go",
+		"context":     entry.Context,
+		"server":      map[string]string{"host": hostname()},
+		"custom":      custom,
+		"body":        body,
+		"notifier":    map[string]string{"name": "gomessguii-logger", "version": "1.0"},
+	}
+	if r.CodeVersion != "" {
+		data["code_version"] = r.CodeVersion
+	}
+
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = rollbarEndpoint
+	}
+	return postJSON(ctx, r.HTTPClient, endpoint, map[string]string{
+		"X-Rollbar-Access-Token": r.AccessToken,
+	}, map[string]any{"data": data})
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"time"
+)
+
+type RuntimeStatsConfig struct {
+	Interval time.Duration
+	Level    string
+}
+
+func (l *Logger) StartRuntimeStats(ctx context.Context, config RuntimeStatsConfig) {
+	if config.Interval <= 0 {
+		config.Interval = time.Minute
+	}
+	if config.Level == "" {
+		config.Level = DEBUG
+	}
+
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if l.enabled(config.Level) {
+					l.WithFields(RuntimeStats()).Log(config.Level, "runtime stats")
+				}
+			}
+		}
+	}()
+}
+
+func RuntimeStats() Fields {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	fields := Fields{
+		"heap_alloc":     mem.HeapAlloc,
+		"heap_objects":   mem.HeapObjects,
+		"sys":            mem.Sys,
+		"num_gc":         mem.NumGC,
+		"gc_pause_total": time.Duration(mem.PauseTotalNs),
+		"goroutines":     runtime.NumGoroutine(),
+	}
+	if mem.NumGC > 0 {
+		fields["gc_pause_last"] = time.Duration(mem.PauseNs[(mem.NumGC+255)%256])
+	}
+	if fds, ok := openFileDescriptors(); ok {
+		fields["open_fds"] = fds
+	}
+	return fields
+}
+
+func openFileDescriptors() (int, bool) {
+	for _, dir := range []string{"/proc/self/fd", "/dev/fd"} {
+		entries, err := os.ReadDir(dir)
+		if err == nil {
+			return len(entries), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+type Sampling struct {
+	Tick       time.Duration
+	First      int
+	Thereafter int
+
+	mu      sync.Mutex
+	resetAt time.Time
+	counts  map[samplingKey]int
+}
+
+type samplingKey struct {
+	level   string
+	message string
+}
+
+func (s *Sampling) allow(logLevel string, message string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.counts == nil || !now.Before(s.resetAt) {
+		tick := s.Tick
+		if tick <= 0 {
+			tick = time.Second
+		}
+		s.counts = make(map[samplingKey]int)
+		s.resetAt = now.Add(tick)
+	}
+
+	key := samplingKey{logLevel, message}
+	s.counts[key]++
+	n := s.counts[key]
+	if n <= s.First {
+		return true
+	}
+	return s.Thereafter > 0 && (n-s.First)%s.Thereafter == 0
+}
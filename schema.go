@@ -0,0 +1,236 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	SchemaString  = "string"
+	SchemaNumber  = "number"
+	SchemaInteger = "integer"
+	SchemaBoolean = "boolean"
+	SchemaObject  = "object"
+	SchemaArray   = "array"
+	SchemaAny     = ""
+)
+
+type Schema struct {
+	Fields           map[string]SchemaField
+	AdditionalFields bool
+}
+
+type SchemaField struct {
+	Type     string
+	Required bool
+	Enum     []any
+}
+
+type SchemaError struct {
+	Message    string
+	Violations []string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("entry %q violates schema: %s", e.Message, strings.Join(e.Violations, "; "))
+}
+
+func (s *Schema) Validate(entry Entry) error {
+	var violations []string
+	names := make([]string, 0, len(s.Fields))
+	for name := range s.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field := s.Fields[name]
+		v, ok := entry.Fields[name]
+		v = fieldValue(v)
+		if !ok {
+			if field.Required {
+				violations = append(violations, fmt.Sprintf("missing required field %q", name))
+			}
+			continue
+		}
+		if !schemaTypeMatches(field.Type, v) {
+			violations = append(violations, fmt.Sprintf("field %q is %T, want %s", name, v, field.Type))
+			continue
+		}
+		if len(field.Enum) > 0 && !slices.ContainsFunc(field.Enum, func(allowed any) bool { return fmt.Sprint(allowed) == fmt.Sprint(v) }) {
+			violations = append(violations, fmt.Sprintf("field %q has value %v outside %v", name, v, field.Enum))
+		}
+	}
+	if !s.AdditionalFields {
+		extra := make([]string, 0)
+		for name := range entry.Fields {
+			if _, ok := s.Fields[name]; !ok {
+				extra = append(extra, name)
+			}
+		}
+		sort.Strings(extra)
+		for _, name := range extra {
+			violations = append(violations, fmt.Sprintf("unexpected field %q", name))
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &SchemaError{Message: strings.TrimSpace(entry.Message), Violations: violations}
+}
+
+func schemaTypeMatches(schemaType string, v any) bool {
+	if schemaType == SchemaAny {
+		return true
+	}
+	if n, ok := v.(json.Number); ok {
+		if schemaType == SchemaInteger {
+			_, err := n.Int64()
+			return err == nil
+		}
+		return schemaType == SchemaNumber
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return schemaType == SchemaString
+	case reflect.Bool:
+		return schemaType == SchemaBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schemaType == SchemaInteger || schemaType == SchemaNumber
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return schemaType == SchemaNumber || (schemaType == SchemaInteger && f == math.Trunc(f))
+	case reflect.Map, reflect.Struct:
+		if _, isTime := v.(time.Time); isTime {
+			return schemaType == SchemaString
+		}
+		return schemaType == SchemaObject
+	case reflect.Slice, reflect.Array:
+		return schemaType == SchemaArray
+	}
+	if _, isErr := v.(error); isErr {
+		return schemaType == SchemaString
+	}
+	return false
+}
+
+func SchemaFromStruct(v any) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("schema source must be a struct, got %T", v)
+	}
+
+	schema := &Schema{Fields: make(map[string]SchemaField, t.NumField())}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		schema.Fields[name] = SchemaField{
+			Type:     schemaTypeOf(f.Type),
+			Required: !slices.Contains(strings.Split(opts, ","), "omitempty"),
+		}
+	}
+	return schema, nil
+}
+
+func schemaTypeOf(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Time{}) {
+		return SchemaString
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaTypeOf(t.Elem())
+	case reflect.String:
+		return SchemaString
+	case reflect.Bool:
+		return SchemaBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return SchemaInteger
+	case reflect.Float32, reflect.Float64:
+		return SchemaNumber
+	case reflect.Map, reflect.Struct:
+		return SchemaObject
+	case reflect.Slice, reflect.Array:
+		return SchemaArray
+	default:
+		return SchemaAny
+	}
+}
+
+func ParseJSONSchema(data []byte) (*Schema, error) {
+	var doc struct {
+		Type       string `json:"type"`
+		Properties map[string]struct {
+			Type any   `json:"type"`
+			Enum []any `json:"enum"`
+		} `json:"properties"`
+		Required             []string `json:"required"`
+		AdditionalProperties *bool    `json:"additionalProperties"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Type != "" && doc.Type != SchemaObject {
+		return nil, errors.New("JSON schema root must be an object")
+	}
+
+	schema := &Schema{
+		Fields:           make(map[string]SchemaField, len(doc.Properties)),
+		AdditionalFields: doc.AdditionalProperties == nil || *doc.AdditionalProperties,
+	}
+	for name, property := range doc.Properties {
+		field := SchemaField{Enum: property.Enum}
+		switch t := property.Type.(type) {
+		case string:
+			field.Type = t
+		case nil:
+		default:
+			return nil, fmt.Errorf("property %q: only single types are supported", name)
+		}
+		field.Required = slices.Contains(doc.Required, name)
+		schema.Fields[name] = field
+	}
+	for _, name := range doc.Required {
+		if _, ok := schema.Fields[name]; !ok {
+			schema.Fields[name] = SchemaField{Required: true}
+		}
+	}
+	return schema, nil
+}
+
+func (l *Logger) validateSchema(entry Entry) {
+	if l.Schema == nil {
+		return
+	}
+	if err := l.Schema.Validate(entry); err != nil {
+		l.handleError(err)
+	}
+}
+
+func (l *Logger) handleError(err error) {
+	if l.ErrorHandler != nil {
+		l.ErrorHandler(err)
+		return
+	}
+	l.logInternal("%v\n", err)
+}
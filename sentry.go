@@ -0,0 +1,145 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const sentryClient = "gomessguii-logger/1.0"
+
+type SentryNotifier struct {
+	DSN          string
+	Environment  string
+	Release      string
+	NotifyLevels []string
+	HTTPClient   *http.Client
+}
+
+func (s *SentryNotifier) Levels() []string {
+	if len(s.NotifyLevels) == 0 {
+		return []string{ERR, FATAL}
+	}
+	return s.NotifyLevels
+}
+
+func (s *SentryNotifier) Notify(ctx context.Context, entry Entry) error {
+	endpoint, publicKey, err := parseSentryDSN(s.DSN)
+	if err != nil {
+		return err
+	}
+
+	eventID := newEventID()
+	message := strings.TrimRight(entry.Message, "\n")
+	event := map[string]any{
+		"event_id":    eventID,
+		"timestamp":   entry.Time.UTC().Format(time.RFC3339Nano),
+		"platform":    "go",
+		"level":       syslogScale(entry.Level, "fatal", "error", "warning", "info", "debug"),
+		"logger":      entry.Context,
+		"server_name": hostname(),
+		"message":     map[string]string{"formatted": message},
+		"tags": map[string]string{
+			"service": entry.Service,
+			"context": entry.Context,
+		},
+	}
+	if s.Environment != "" {
+		event["environment"] = s.Environment
+	}
+	if s.Release != "" {
+		event["release"] = s.Release
+	}
+	if len(entry.Fields) > 0 {
+		event["extra"] = jsonFields(entry.Fields)
+	}
+	if levelRank(entry.Level) >= levelRank(ERR) {
+		exception := map[string]any{
+			"type":  firstLine(message),
+			"value": message,
+		}
+		if frames := sentryFrames(entry.Stack); len(frames) > 0 {
+			exception["stacktrace"] = map[string]any{"frames": frames}
+		}
+		event["exception"] = map[string]any{"values": []any{exception}}
+	}
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	header, _ := json.Marshal(map[string]string{
+		"event_id": eventID,
+		"sent_at":  time.Now().UTC().Format(time.RFC3339Nano),
+		"dsn":      s.DSN,
+	})
+	itemHeader, _ := json.Marshal(map[string]any{"type": "event", "length": len(eventJSON)})
+
+	var body bytes.Buffer
+	body.Write(header)
+	body.WriteByte('\n')
+	body.Write(itemHeader)
+	body.WriteByte('\n')
+	body.Write(eventJSON)
+	body.WriteByte('\n')
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s", sentryClient, publicKey))
+
+	return doRequest(s.HTTPClient, req)
+}
+
+func parseSentryDSN(dsn string) (endpoint string, publicKey string, err error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid sentry DSN: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return "", "", fmt.Errorf("invalid sentry DSN: missing public key")
+	}
+	path := strings.Trim(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	projectID := path[i+1:]
+	if projectID == "" {
+		return "", "", fmt.Errorf("invalid sentry DSN: missing project id")
+	}
+
+	prefix := ""
+	if i >= 0 {
+		prefix = "/" + path[:i]
+	}
+	endpoint = fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, prefix, projectID)
+	return endpoint, u.User.Username(), nil
+}
+
+func sentryFrames(stack []Frame) []map[string]any {
+	frames := make([]map[string]any, 0, len(stack))
+	for i := len(stack) - 1; i >= 0; i-- {
+		frame := stack[i]
+		frames = append(frames, map[string]any{
+			"function": frame.Function,
+			"abs_path": frame.File,
+			"filename": frame.File,
+			"lineno":   frame.Line,
+			"in_app":   !strings.HasPrefix(frame.Function, "runtime.") && !strings.Contains(frame.File, "/go/src/"),
+		})
+	}
+	return frames
+}
+
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,103 @@
+package logger
+
+import "sync"
+
+type SeverityMapping struct {
+	Names  map[string]string
+	Syslog map[string]int
+	GCP    map[string]string
+	OTel   map[string]int
+}
+
+var DefaultSeverityMapping = SeverityMapping{
+	Names: map[string]string{
+		TRACE: "trace",
+		DEBUG: "debug",
+		INFO:  "info",
+		WARN:  "warning",
+		ERR:   "error",
+		FATAL: "critical",
+	},
+	Syslog: map[string]int{
+		TRACE: 7,
+		DEBUG: 7,
+		INFO:  6,
+		WARN:  4,
+		ERR:   3,
+		FATAL: 2,
+	},
+	GCP: map[string]string{
+		TRACE: "DEBUG",
+		DEBUG: "DEBUG",
+		INFO:  "INFO",
+		WARN:  "WARNING",
+		ERR:   "ERROR",
+		FATAL: "CRITICAL",
+	},
+	OTel: map[string]int{
+		TRACE: 1,
+		DEBUG: 5,
+		INFO:  9,
+		WARN:  13,
+		ERR:   17,
+		FATAL: 21,
+	},
+}
+
+var (
+	severityMu      sync.RWMutex
+	severityMapping = DefaultSeverityMapping
+)
+
+func SetSeverityMapping(mapping SeverityMapping) {
+	severityMu.Lock()
+	defer severityMu.Unlock()
+	severityMapping = mapping
+}
+
+func currentSeverityMapping() SeverityMapping {
+	severityMu.RLock()
+	defer severityMu.RUnlock()
+	return severityMapping
+}
+
+func SeverityName(logLevel string) string {
+	return severityLookup(currentSeverityMapping().Names, DefaultSeverityMapping.Names, logLevel)
+}
+
+func SyslogSeverity(logLevel string) int {
+	return severityLookup(currentSeverityMapping().Syslog, DefaultSeverityMapping.Syslog, logLevel)
+}
+
+func GCPSeverity(logLevel string) string {
+	return severityLookup(currentSeverityMapping().GCP, DefaultSeverityMapping.GCP, logLevel)
+}
+
+func OTelSeverity(logLevel string) int {
+	return severityLookup(currentSeverityMapping().OTel, DefaultSeverityMapping.OTel, logLevel)
+}
+
+func severityLookup[T any](mapping map[string]T, defaults map[string]T, logLevel string) T {
+	if v, ok := mapping[logLevel]; ok {
+		return v
+	}
+	if v, ok := mapping[levelLabel(logLevel)]; ok {
+		return v
+	}
+	return defaults[levelLabel(logLevel)]
+}
+
+func syslogScale[T any](logLevel string, critical, err, warning, info, debug T) T {
+	switch severity := SyslogSeverity(logLevel); {
+	case severity <= 2:
+		return critical
+	case severity == 3:
+		return err
+	case severity <= 5:
+		return warning
+	case severity == 6:
+		return info
+	default:
+		return debug
+	}
+}
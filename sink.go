@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Sink interface {
+	Write(entry Entry) error
+	Close() error
+}
+
+func (l *Logger) writeSinks(entry Entry) {
+	for _, sink := range l.Sinks {
+		if err := sink.Write(entry); err != nil {
+			var evicted *evictedError
+			if errors.As(err, &evicted) {
+				for _, dropped := range evicted.entries {
+					l.drop(dropped, DropQueueFull)
+				}
+				continue
+			}
+			if isQueueFull(err) {
+				l.drop(entry, DropQueueFull)
+			}
+			l.logInternal("Failed to write to sink: %v\n", err)
+			l.alertSinkFailure(sink, err)
+		}
+	}
+}
+
+func (l *Logger) Close() error {
+	l.Flush()
+	var errs []error
+	for _, sink := range l.Sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+const sinkAlertInterval = time.Minute
+
+func (l *Logger) alertSinkFailure(sink Sink, err error) {
+	if len(l.WebhookConfig.urlsFor(ERR)) == 0 {
+		return
+	}
+	key := fmt.Sprintf("%T", sink)
+	now := l.now()
+
+	state := l.shared()
+	stateMu.Lock()
+	if state.sinkAlerts == nil {
+		state.sinkAlerts = make(map[string]time.Time)
+	}
+	if last, ok := state.sinkAlerts[key]; ok && now.Sub(last) < sinkAlertInterval {
+		stateMu.Unlock()
+		return
+	}
+	state.sinkAlerts[key] = now
+	stateMu.Unlock()
+
+	alert := l.newEntry(ERR, fmt.Sprintf("Sink %s is failing, entries are falling back: %v", key, err))
+	l.deliverWebhook(alert.WithField("sink_error", err.Error()))
+}
+
+var (
+	ErrSinkClosed       = errors.New("sink is closed")
+	ErrOSLogUnsupported = errors.New("os_log is only available on macOS with cgo enabled")
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+	defaultBufferSize    = 10000
+)
+
+type entryBatcher struct {
+	batchSize     int
+	flushInterval time.Duration
+	send          func(entries []Entry) error
+	onError       func(err error)
+	policy        string
+	dropped       atomic.Uint64
+
+	once    sync.Once
+	mu      sync.RWMutex
+	closed  bool
+	entries chan Entry
+	done    chan struct{}
+}
+
+func newEntryBatcher(batchSize int, flushInterval time.Duration, policy string, send func([]Entry) error, onError func(error)) *entryBatcher {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	if onError == nil {
+		onError = func(err error) {
+			log.Printf("Failed to ship log batch: %v", err)
+		}
+	}
+	return &entryBatcher{
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		send:          send,
+		onError:       onError,
+		policy:        policy,
+		entries:       make(chan Entry, max(defaultBufferSize, batchSize)),
+		done:          make(chan struct{}),
+	}
+}
+
+func (b *entryBatcher) add(entry Entry) error {
+	b.once.Do(func() {
+		go b.run()
+	})
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrSinkClosed
+	}
+	var evicted []Entry
+	accepted := offer(b.entries, entry, b.policy, func(dropped Entry) {
+		b.dropped.Add(1)
+		evicted = append(evicted, dropped)
+	})
+	if !accepted {
+		return ErrQueueFull
+	}
+	if len(evicted) > 0 {
+		return &evictedError{entries: evicted}
+	}
+	return nil
+}
+
+func (b *entryBatcher) close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.entries)
+	b.mu.Unlock()
+
+	b.once.Do(func() {
+		go b.run()
+	})
+	<-b.done
+	return nil
+}
+
+func (b *entryBatcher) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Entry, 0, b.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := b.send(batch); err != nil {
+			b.onError(err)
+		}
+		batch = make([]Entry, 0, b.batchSize)
+	}
+
+	for {
+		select {
+		case entry, ok := <-b.entries:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, entry)
+			if len(batch) >= b.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
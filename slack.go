@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	slackMaxHeader  = 150
+	slackMaxSection = 3000
+
+	slackDetailSeparator = "  |  "
+)
+
+var levelHexColors = map[string]string{
+	TRACE: "#bdbdbd",
+	DEBUG: "#9e9e9e",
+	INFO:  "#439fe0",
+	WARN:  "#daa038",
+	ERR:   "#d00000",
+	FATAL: "#7b0000",
+}
+
+func levelHexColor(logLevel string) string {
+	if color, ok := levelHexColors[logLevel]; ok {
+		return color
+	}
+	return levelHexColors[INFO]
+}
+
+type SlackPayload struct{}
+
+func (SlackPayload) Build(entry Entry) (string, []byte, error) {
+	title := fmt.Sprintf("[%s] %s", levelLabel(entry.Level), entry.Service)
+	if entry.Context != "" {
+		title += " / " + entry.Context
+	}
+
+	details := []string{fmt.Sprintf("<!date^%d^{date_short_pretty} {time_secs}|%s>", entry.Time.Unix(), entry.Time.UTC().Format("2006-01-02 15:04:05 UTC"))}
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	budget := slackMaxSection - utf8.RuneCountInString(details[0])
+	for _, k := range keys {
+		label := slackDetailSeparator + "*" + slackEscapeLimit(k, slackMaxHeader) + ":* "
+		room := budget - utf8.RuneCountInString(label)
+		if room < 1 {
+			break
+		}
+		value := slackEscapeLimit(fmt.Sprint(entry.Fields[k]), room)
+		details = append(details, label[len(slackDetailSeparator):]+value)
+		budget = room - utf8.RuneCountInString(value)
+	}
+
+	payload := map[string]any{
+		"text": title + ": " + entry.Message,
+		"attachments": []map[string]any{{
+			"color":    levelHexColor(entry.Level),
+			"fallback": title + ": " + entry.Message,
+			"blocks": []map[string]any{
+				{
+					"type": "header",
+					"text": map[string]any{"type": "plain_text", "text": truncateText(title, slackMaxHeader)},
+				},
+				{
+					"type": "section",
+					"text": map[string]any{"type": "mrkdwn", "text": "```" + slackEscapeLimit(entry.Message, slackMaxSection-6) + "```"},
+				},
+				{
+					"type":     "context",
+					"elements": []map[string]any{{"type": "mrkdwn", "text": strings.Join(details, slackDetailSeparator)}},
+				},
+			},
+		}},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	return "application/json", body, nil
+}
+
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func slackEscapeLimit(s string, limit int) string {
+	escaped := slackEscape(s)
+	if utf8.RuneCountInString(escaped) <= limit {
+		return escaped
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range s {
+		part := slackEscape(string(r))
+		if n+utf8.RuneCountInString(part) > limit-1 {
+			break
+		}
+		sb.WriteString(part)
+		n += utf8.RuneCountInString(part)
+	}
+	sb.WriteString("…")
+	return sb.String()
+}
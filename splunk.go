@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+type SplunkSink struct {
+	URL            string
+	Token          string
+	Index          string
+	Source         string
+	Sourcetype     string
+	BatchSize      int
+	FlushInterval  time.Duration
+	Compress       bool
+	HTTPClient     *http.Client
+	ErrorHandler   func(err error)
+	OverflowPolicy string
+
+	once    sync.Once
+	batcher *entryBatcher
+}
+
+func (s *SplunkSink) Write(entry Entry) error {
+	return s.batch().add(entry)
+}
+
+func (s *SplunkSink) Close() error {
+	return s.batch().close()
+}
+
+func (s *SplunkSink) Dropped() uint64 {
+	return s.batch().dropped.Load()
+}
+
+func (s *SplunkSink) batch() *entryBatcher {
+	s.once.Do(func() {
+		s.batcher = newEntryBatcher(s.BatchSize, s.FlushInterval, s.OverflowPolicy, s.send, s.ErrorHandler)
+	})
+	return s.batcher
+}
+
+func (s *SplunkSink) send(entries []Entry) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, entry := range entries {
+		event := map[string]any{
+			"level":    entry.Level,
+			"severity": SeverityName(entry.Level),
+			"service":  entry.Service,
+			"message":  strings.TrimRight(entry.Message, "\n"),
+		}
+		if entry.Context != "" {
+			event["context"] = entry.Context
+		}
+		if fields := jsonFields(entry.Fields); fields != nil {
+			event["fields"] = fields
+		}
+
+		record := map[string]any{
+			"time":  float64(entry.Time.UnixMicro()) / 1e6,
+			"host":  hostname(),
+			"event": event,
+		}
+		if s.Index != "" {
+			record["index"] = s.Index
+		}
+		if s.Source != "" {
+			record["source"] = s.Source
+		} else {
+			record["source"] = entry.Service
+		}
+		if s.Sourcetype != "" {
+			record["sourcetype"] = s.Sourcetype
+		}
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	headers := map[string]string{"Authorization": "Splunk " + s.Token}
+	url := strings.TrimRight(s.URL, "/") + "/services/collector/event"
+	return postBody(context.Background(), s.HTTPClient, url, "application/json", headers, body.Bytes(), s.Compress)
+}
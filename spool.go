@@ -0,0 +1,174 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultSpoolMaxFiles       = 1000
+	defaultSpoolReplayInterval = 100 * time.Millisecond
+)
+
+var spoolSequence atomic.Uint64
+
+type spoolRecord struct {
+	URL         string    `json:"url"`
+	ContentType string    `json:"contentType"`
+	Payload     []byte    `json:"payload"`
+	FailedAt    time.Time `json:"failedAt"`
+}
+
+func spool(job webhookJob, url string) error {
+	if err := os.MkdirAll(job.config.SpoolDir, 0o700); err != nil {
+		return err
+	}
+
+	maxFiles := job.config.SpoolMaxFiles
+	if maxFiles <= 0 {
+		maxFiles = defaultSpoolMaxFiles
+	}
+	files, err := spoolFiles(job.config.SpoolDir)
+	if err != nil {
+		return err
+	}
+	if len(files) >= maxFiles {
+		return fmt.Errorf("spool directory %s is full (%d files)", job.config.SpoolDir, len(files))
+	}
+
+	record, err := json.Marshal(spoolRecord{
+		URL:         url,
+		ContentType: job.contentType,
+		Payload:     job.payload,
+		FailedAt:    time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%020d-%06d.json", time.Now().UnixNano(), spoolSequence.Add(1)%1000000)
+	tmp := filepath.Join(job.config.SpoolDir, name+".tmp")
+	if err := os.WriteFile(tmp, record, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(job.config.SpoolDir, name))
+}
+
+func (l *Logger) ReplaySpool() {
+	if l.WebhookConfig.SpoolDir == "" {
+		return
+	}
+	l.webhooks().startReplay(l.WebhookConfig, "")
+}
+
+func (d *webhookDispatcher) startReplay(config WebhookConfig, url string) {
+	d.mu.Lock()
+	if d.replayDone != nil {
+		d.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(d.ctx)
+	done := make(chan struct{})
+	d.replayCancel, d.replayDone = cancel, done
+	d.mu.Unlock()
+	go func() {
+		defer close(done)
+		defer cancel()
+		d.replaySpool(ctx, config, url)
+		d.mu.Lock()
+		d.replayCancel, d.replayDone = nil, nil
+		d.mu.Unlock()
+	}()
+}
+
+func (d *webhookDispatcher) stopReplay() {
+	d.mu.Lock()
+	cancel, done := d.replayCancel, d.replayDone
+	d.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (d *webhookDispatcher) replaySpool(ctx context.Context, config WebhookConfig, url string) {
+	interval := config.SpoolReplayInterval
+	if interval <= 0 {
+		interval = defaultSpoolReplayInterval
+	}
+	breaker := config.CircuitBreaker
+
+	files, err := spoolFiles(config.SpoolDir)
+	if err != nil {
+		d.logger.logInternal("Failed to read webhook spool: %v\n", err)
+		return
+	}
+
+	failed := map[string]bool{}
+	sent := false
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		var record spoolRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			d.logger.logInternal("Discarding corrupt webhook spool file %s: %v\n", file, err)
+			_ = os.Remove(file)
+			continue
+		}
+		if (url != "" && record.URL != url) || failed[record.URL] {
+			continue
+		}
+
+		if sent && !sleepContext(ctx, interval) {
+			return
+		}
+		if breaker != nil && !d.breakerAllows(record.URL, breaker) {
+			failed[record.URL] = true
+			continue
+		}
+		sent = true
+		job := webhookJob{config: config, contentType: record.ContentType, payload: record.Payload, replayed: true}
+		err = post(ctx, job, record.URL)
+		if breaker != nil {
+			d.breakerResult(record.URL, breaker, err)
+		}
+		if err != nil {
+			failed[record.URL] = true
+			if url != "" {
+				return
+			}
+			continue
+		}
+		d.record(record.URL, nil)
+		_ = os.Remove(file)
+	}
+}
+
+func spoolFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
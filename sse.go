@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const sseKeepAlive = 15 * time.Second
+
+type sseHandler struct {
+	broadcaster *Broadcaster
+}
+
+func (b *Broadcaster) SSEHandler() http.Handler {
+	return sseHandler{broadcaster: b}
+}
+
+func (h sseHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	query := r.URL.Query()
+	var encoder Encoder = TextEncoder{}
+	if query.Get("format") == FormatJSON {
+		encoder = JSONEncoder{}
+	}
+
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if controller.Flush() != nil {
+		return
+	}
+
+	sub := h.broadcaster.subscribe(streamFilter{Level: query.Get("level"), Context: query.Get("context")})
+	defer h.broadcaster.unsubscribe(sub)
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := w.Write([]byte(": keepalive\n\n")); err != nil || controller.Flush() != nil {
+				return
+			}
+		case entry, ok := <-sub.entries:
+			if !ok {
+				return
+			}
+			b, err := encoder.Encode(entry)
+			if err != nil {
+				continue
+			}
+			if _, err := w.Write(sseEvent(levelLabel(entry.Level), b)); err != nil || controller.Flush() != nil {
+				return
+			}
+		}
+	}
+}
+
+func sseEvent(event string, data []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("event: " + strings.ToLower(event) + "\n")
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		buf.WriteString("data: " + line + "\n")
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const maxStackFrames = 64
+
+var packagePath = reflect.TypeOf(Logger{}).PkgPath()
+
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+func (f Frame) String() string {
+	return filepath.Base(f.File) + ":" + strconv.Itoa(f.Line)
+}
+
+func captureStack() []Frame {
+	pcs := make([]uintptr, maxStackFrames)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	stack := make([]Frame, 0, n)
+	for {
+		frame, more := frames.Next()
+		if !isLoggerFrame(frame.Function) || len(stack) > 0 {
+			stack = append(stack, Frame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		}
+		if !more {
+			break
+		}
+	}
+	return stack
+}
+
+const (
+	StackMultiline = "multiline"
+	StackCompact   = "compact"
+	StackOmit      = "omit"
+)
+
+type StackFormat struct {
+	Style      string
+	MaxFrames  int
+	TrimPrefix string
+	ShortPaths bool
+}
+
+func (s StackFormat) frames(stack []Frame) []Frame {
+	if s.Style == StackOmit {
+		return nil
+	}
+	if s.MaxFrames > 0 && len(stack) > s.MaxFrames {
+		stack = stack[:s.MaxFrames]
+	}
+	if s.TrimPrefix == "" && !s.ShortPaths {
+		return stack
+	}
+	trimmed := make([]Frame, len(stack))
+	for i, frame := range stack {
+		frame.File = strings.TrimPrefix(frame.File, s.TrimPrefix)
+		if s.ShortPaths {
+			frame.File = filepath.Base(frame.File)
+		}
+		trimmed[i] = frame
+	}
+	return trimmed
+}
+
+func (s StackFormat) compact(stack []Frame) []string {
+	lines := make([]string, len(stack))
+	for i, frame := range stack {
+		lines[i] = frame.Function + " " + frame.File + ":" + strconv.Itoa(frame.Line)
+	}
+	return lines
+}
+
+func (s StackFormat) text(stack []Frame) string {
+	stack = s.frames(stack)
+	if len(stack) == 0 {
+		return ""
+	}
+	if s.Style == StackCompact {
+		return " stack=" + strconv.Quote(strings.Join(s.compact(stack), " < "))
+	}
+	return "\n" + formatStack(stack)
+}
+
+func (s StackFormat) json(stack []Frame) any {
+	stack = s.frames(stack)
+	if len(stack) == 0 {
+		return nil
+	}
+	if s.Style == StackCompact {
+		return s.compact(stack)
+	}
+	return stack
+}
+
+func formatStack(stack []Frame) string {
+	var sb strings.Builder
+	for i, frame := range stack {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(frame.Function)
+		sb.WriteString("\n\t")
+		sb.WriteString(frame.File)
+		sb.WriteByte(':')
+		sb.WriteString(strconv.Itoa(frame.Line))
+	}
+	return sb.String()
+}
+
+func caller() *Frame {
+	pcs := make([]uintptr, maxStackFrames)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !isLoggerFrame(frame.Function) {
+			return &Frame{Function: frame.Function, File: frame.File, Line: frame.Line}
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+func isLoggerFrame(function string) bool {
+	if strings.HasPrefix(function, "log.") {
+		return true
+	}
+	rest, ok := strings.CutPrefix(function, packagePath+".")
+	return ok && !strings.Contains(rest, "/")
+}
+
+var hostname = sync.OnceValue(func() string {
+	name, _ := os.Hostname()
+	return name
+})
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	stdLogMu       sync.Mutex
+	hijackedStdLog atomic.Pointer[log.Logger]
+)
+
+type stdLogWriter struct {
+	logger *Logger
+	level  string
+}
+
+func (w stdLogWriter) Write(p []byte) (int, error) {
+	w.logger.logLine(w.level, strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+func (l *Logger) HijackStdLog(logLevel string) (restore func()) {
+	stdLogMu.Lock()
+	defer stdLogMu.Unlock()
+
+	writer, flags, prefix := log.Writer(), log.Flags(), log.Prefix()
+	previous := hijackedStdLog.Load()
+	if previous == nil {
+		hijackedStdLog.Store(log.New(writer, prefix, flags))
+	}
+	log.SetFlags(0)
+	log.SetPrefix("")
+	log.SetOutput(stdLogWriter{logger: l, level: logLevel})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			stdLogMu.Lock()
+			defer stdLogMu.Unlock()
+			log.SetOutput(writer)
+			log.SetFlags(flags)
+			log.SetPrefix(prefix)
+			hijackedStdLog.Store(previous)
+		})
+	}
+}
+
+func consoleLog() *log.Logger {
+	if std := hijackedStdLog.Load(); std != nil {
+		return std
+	}
+	return log.Default()
+}
@@ -0,0 +1,70 @@
+package logger
+
+import "os"
+
+const badKey = "!BADKEY"
+
+func (l *Logger) WithFields(fields Fields) *Logger {
+	child := l.clone()
+	child.Fields = l.groupFields(fields)
+	return child
+}
+
+func (l *Logger) clone() *Logger {
+	l.shared()
+	child := *l
+	return &child
+}
+
+func (l *Logger) WithField(key string, value any) *Logger {
+	return l.WithFields(Fields{key: value})
+}
+
+func keyValueFields(keysAndValues []any) Fields {
+	fields := make(Fields, len(keysAndValues)/2+1)
+	for i := 0; i < len(keysAndValues); {
+		if f, ok := keysAndValues[i].(Field); ok {
+			fields[f.Key] = f
+			i++
+			continue
+		}
+		key, ok := keysAndValues[i].(string)
+		if !ok || i+1 == len(keysAndValues) {
+			fields[badKey] = keysAndValues[i]
+			i++
+			continue
+		}
+		fields[key] = keysAndValues[i+1]
+		i += 2
+	}
+	return fields
+}
+
+func (l *Logger) sugared(keysAndValues []any) *Logger {
+	if len(keysAndValues) == 0 {
+		return l
+	}
+	return l.WithFields(keyValueFields(keysAndValues))
+}
+
+func (l *Logger) Logw(logLevel string, msg string, keysAndValues ...any) {
+	l.sugared(keysAndValues).emitln(logLevel, []any{msg}, false, false)
+}
+
+func (l *Logger) LogInfow(msg string, keysAndValues ...any) {
+	l.Logw(INFO, msg, keysAndValues...)
+}
+
+func (l *Logger) LogErrorw(msg string, keysAndValues ...any) {
+	l.sugared(keysAndValues).emitln(ERR, []any{msg}, l.WebhookConfig.sends(ERR), true)
+}
+
+func (l *Logger) LogFatalw(msg string, keysAndValues ...any) {
+	l.sugared(keysAndValues).emitln(FATAL, []any{msg}, l.WebhookConfig.sends(FATAL), true)
+	l.Flush()
+	os.Exit(1)
+}
+
+func (l *Logger) LogWarnw(msg string, keysAndValues ...any) {
+	l.sugared(keysAndValues).emitln(WARN, []any{msg}, l.WebhookConfig.sends(WARN), false)
+}
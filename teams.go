@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type TeamsPayload struct {
+	MessageCard bool
+}
+
+func (p TeamsPayload) Build(entry Entry) (string, []byte, error) {
+	title := fmt.Sprintf("[%s] %s", levelLabel(entry.Level), entry.Service)
+	if entry.Context != "" {
+		title += " / " + entry.Context
+	}
+
+	type fact struct {
+		Name  string `json:"name,omitempty"`
+		Title string `json:"title,omitempty"`
+		Value string `json:"value"`
+	}
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	facts := []fact{{Name: "Time", Value: entry.Time.Format(time.RFC3339)}}
+	for _, k := range keys {
+		facts = append(facts, fact{Name: k, Value: fmt.Sprint(entry.Fields[k])})
+	}
+
+	var payload any
+	if p.MessageCard {
+		payload = map[string]any{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    title,
+			"themeColor": strings.TrimPrefix(levelHexColor(entry.Level), "#"),
+			"title":      title,
+			"sections": []map[string]any{{
+				"text":  entry.Message,
+				"facts": facts,
+			}},
+		}
+	} else {
+		for i := range facts {
+			facts[i].Title, facts[i].Name = facts[i].Name, ""
+		}
+		payload = map[string]any{
+			"type": "message",
+			"attachments": []map[string]any{{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]any{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body": []map[string]any{
+						{
+							"type":   "TextBlock",
+							"text":   title,
+							"weight": "Bolder",
+							"size":   "Medium",
+							"color":  teamsLevelColor(entry.Level),
+						},
+						{
+							"type": "TextBlock",
+							"text": entry.Message,
+							"wrap": true,
+						},
+						{
+							"type":  "FactSet",
+							"facts": facts,
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	return "application/json", body, nil
+}
+
+func teamsLevelColor(logLevel string) string {
+	switch logLevel {
+	case ERR, FATAL:
+		return "Attention"
+	case WARN:
+		return "Warning"
+	case TRACE, DEBUG:
+		return "Default"
+	default:
+		return "Accent"
+	}
+}
@@ -0,0 +1,164 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	telegramAPIURL     = "https://api.telegram.org"
+	telegramMaxMessage = 4096
+)
+
+type TelegramNotifier struct {
+	BotToken     string
+	ChatID       string
+	NotifyLevels []string
+	MaxRetries   int
+	HTTPClient   *http.Client
+	APIURL       string
+}
+
+func (t *TelegramNotifier) Levels() []string {
+	return notifyLevels(t.NotifyLevels)
+}
+
+func (t *TelegramNotifier) Notify(ctx context.Context, entry Entry) error {
+	body, err := json.Marshal(map[string]any{
+		"chat_id":                  t.ChatID,
+		"text":                     telegramMessage(entry),
+		"parse_mode":               "MarkdownV2",
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+
+	maxRetries := t.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 3
+	}
+	retryAfter, err := t.send(ctx, body)
+	if err != nil && retryAfter > 0 {
+		return &retryAfterError{err: err, after: retryAfter, maxRetries: maxRetries}
+	}
+	return err
+}
+
+func (t *TelegramNotifier) send(ctx context.Context, body []byte) (time.Duration, error) {
+	apiURL := t.APIURL
+	if apiURL == "" {
+		apiURL = telegramAPIURL
+	}
+	ctx, cancel := withDefaultTimeout(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/bot"+t.BotToken+"/sendMessage", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := t.HTTPClient
+	if client == nil {
+		client = defaultWebhookClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func(body io.ReadCloser) {
+		_ = body.Close()
+	}(resp.Body)
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+		Parameters  struct {
+			RetryAfter int `json:"retry_after"`
+		} `json:"parameters"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+	if resp.StatusCode == http.StatusOK && result.OK {
+		return 0, nil
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter := time.Duration(max(result.Parameters.RetryAfter, 1)) * time.Second
+		return retryAfter, fmt.Errorf("telegram rate limit exceeded, retry after %s", retryAfter)
+	}
+	return 0, fmt.Errorf("telegram responded with status %s: %s", resp.Status, result.Description)
+}
+
+func telegramMessage(entry Entry) string {
+	title := fmt.Sprintf("[%s] %s", levelLabel(entry.Level), entry.Service)
+	if entry.Context != "" {
+		title += " / " + entry.Context
+	}
+
+	segments := []telegramSegment{
+		{open: "*", text: title, close: "*\n"},
+		{text: strings.TrimRight(entry.Message, "\n")},
+	}
+	keys := make([]string, 0, len(entry.Fields))
+	for k := range entry.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		segments = append(segments,
+			telegramSegment{open: "\n_", text: k, close: "_"},
+			telegramSegment{open: ": ", text: fmt.Sprint(entry.Fields[k])},
+		)
+	}
+
+	var sb strings.Builder
+	budget := telegramMaxMessage
+	for _, segment := range segments {
+		escaped := EscapeTelegramMarkdown(segment.text)
+		size := utf8.RuneCountInString(segment.open + escaped + segment.close)
+		if size <= budget {
+			sb.WriteString(segment.open + escaped + segment.close)
+			budget -= size
+			continue
+		}
+
+		room := budget - utf8.RuneCountInString(segment.open+segment.close) - 1
+		if room >= 0 {
+			sb.WriteString(segment.open)
+			for _, r := range segment.text {
+				part := EscapeTelegramMarkdown(string(r))
+				if room -= utf8.RuneCountInString(part); room < 0 {
+					break
+				}
+				sb.WriteString(part)
+			}
+			sb.WriteString("…" + segment.close)
+		}
+		break
+	}
+	return sb.String()
+}
+
+type telegramSegment struct {
+	open  string
+	text  string
+	close string
+}
+
+func EscapeTelegramMarkdown(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune("_*[]()~`>#+-=|{}.!\\", r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
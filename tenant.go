@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+const TenantField = "tenant"
+
+type TenantConfig struct {
+	Sinks      []Sink
+	Webhook    *WebhookConfig
+	RateLimit  int
+	RatePeriod time.Duration
+}
+
+type tenantLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	period  time.Duration
+	resetAt time.Time
+	count   int
+}
+
+func (l *Logger) ForTenant(tenant string) *Logger {
+	child := l.withRootFields(Fields{TenantField: tenant})
+	config, ok := l.Tenants[tenant]
+	if !ok {
+		return child
+	}
+
+	if len(config.Sinks) > 0 {
+		child.Sinks = append(slices.Clip(l.Sinks), config.Sinks...)
+	}
+	if config.Webhook != nil {
+		child.WebhookConfig = *config.Webhook
+	}
+	if config.RateLimit > 0 {
+		limiter := child.tenantLimiter(tenant, config)
+		child.Filters = append(slices.Clip(l.Filters), func(entry Entry) bool {
+			return limiter.allow(child.now())
+		})
+	}
+	return child
+}
+
+func (l *Logger) tenantLimiter(tenant string, config TenantConfig) *tenantLimiter {
+	state := l.shared()
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if state.tenantLimiters == nil {
+		state.tenantLimiters = make(map[string]*tenantLimiter)
+	}
+	limiter, ok := state.tenantLimiters[tenant]
+	if !ok {
+		period := config.RatePeriod
+		if period <= 0 {
+			period = time.Second
+		}
+		limiter = &tenantLimiter{limit: config.RateLimit, period: period}
+		state.tenantLimiters[tenant] = limiter
+	}
+	return limiter
+}
+
+func (t *tenantLimiter) allow(now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !now.Before(t.resetAt) {
+		t.resetAt = now.Add(t.period)
+		t.count = 0
+	}
+	t.count++
+	return t.count <= t.limit
+}
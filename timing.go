@@ -0,0 +1,13 @@
+package logger
+
+func (l *Logger) TrackTime(name string) func() {
+	start := l.now()
+	return func() {
+		elapsed := l.now().Sub(start)
+		if l.SlowThreshold > 0 && elapsed >= l.SlowThreshold {
+			l.LogWarn("%s took %s (threshold %s)", name, elapsed, l.SlowThreshold)
+			return
+		}
+		l.LogDebug("%s took %s", name, elapsed)
+	}
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+type loggingTransport struct {
+	logger *Logger
+	base   http.RoundTripper
+}
+
+type retryCounterKey struct{}
+
+type retryCounter struct {
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+func TrackRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryCounterKey{}, &retryCounter{attempts: make(map[string]int)})
+}
+
+func (l *Logger) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &loggingTransport{logger: l, base: rt}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	url := req.URL.Redacted()
+	retries := attempt(req.Context(), req.Method+" "+url)
+	start := t.logger.now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := t.logger.now().Sub(start)
+
+	fields := Fields{"method": req.Method, "url": url, "duration": elapsed}
+	if retries > 0 {
+		fields["retries"] = retries
+	}
+	child := t.logger.WithFields(fields)
+	switch {
+	case err != nil:
+		child.LogError("HTTP %s %s failed after %s: %v", req.Method, url, elapsed, err)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		child.WithField("status", resp.StatusCode).LogError("HTTP %s %s responded %s in %s", req.Method, url, resp.Status, elapsed)
+	case t.logger.SlowThreshold > 0 && elapsed >= t.logger.SlowThreshold:
+		child.WithField("status", resp.StatusCode).LogWarn("HTTP %s %s responded %s in %s (threshold %s)", req.Method, url, resp.Status, elapsed, t.logger.SlowThreshold)
+	default:
+		child.WithField("status", resp.StatusCode).LogDebug("HTTP %s %s responded %s in %s", req.Method, url, resp.Status, elapsed)
+	}
+	return resp, err
+}
+
+func attempt(ctx context.Context, key string) int {
+	counter, ok := ctx.Value(retryCounterKey{}).(*retryCounter)
+	if !ok {
+		return 0
+	}
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	retries := counter.attempts[key]
+	counter.attempts[key] = retries + 1
+	return retries
+}
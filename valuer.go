@@ -0,0 +1,46 @@
+package logger
+
+const maxLogValueDepth = 100
+
+type LogValuer interface {
+	LogValue() any
+}
+
+func resolveValue(v any) any {
+	for range maxLogValueDepth {
+		valuer, ok := v.(LogValuer)
+		if !ok {
+			break
+		}
+		v = valuer.LogValue()
+	}
+	if fields, ok := v.(Fields); ok {
+		return resolveFields(fields)
+	}
+	return v
+}
+
+func resolveFields(fields Fields) Fields {
+	var resolved Fields
+	for k, v := range fields {
+		if f, ok := v.(Field); ok && f.kind == anyKind {
+			v = f.iface
+		}
+		switch v.(type) {
+		case LogValuer, Fields:
+		default:
+			continue
+		}
+		if resolved == nil {
+			resolved = make(Fields, len(fields))
+			for k, v := range fields {
+				resolved[k] = v
+			}
+		}
+		resolved[k] = resolveValue(v)
+	}
+	if resolved == nil {
+		return fields
+	}
+	return resolved
+}
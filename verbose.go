@@ -0,0 +1,41 @@
+//go:build !noverbose
+
+package logger
+
+const VerboseEnabled = true
+
+func (l *Logger) LogTrace(format string, v ...any) {
+	l.Log(TRACE, format, v...)
+}
+
+func (l *Logger) LogTraceln(v ...any) {
+	l.Logln(TRACE, v...)
+}
+
+func (l *Logger) LogTracew(msg string, keysAndValues ...any) {
+	l.Logw(TRACE, msg, keysAndValues...)
+}
+
+func (l *Logger) LogTraceFunc(fn func() string) {
+	if l.enabled(TRACE) {
+		l.Logln(TRACE, fn())
+	}
+}
+
+func (l *Logger) LogDebug(format string, v ...any) {
+	l.Log(DEBUG, format, v...)
+}
+
+func (l *Logger) LogDebugln(v ...any) {
+	l.Logln(DEBUG, v...)
+}
+
+func (l *Logger) LogDebugw(msg string, keysAndValues ...any) {
+	l.Logw(DEBUG, msg, keysAndValues...)
+}
+
+func (l *Logger) LogDebugFunc(fn func() string) {
+	if l.enabled(DEBUG) {
+		l.Logln(DEBUG, fn())
+	}
+}
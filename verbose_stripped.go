@@ -0,0 +1,21 @@
+//go:build noverbose
+
+package logger
+
+const VerboseEnabled = false
+
+func (l *Logger) LogTrace(format string, v ...any) {}
+
+func (l *Logger) LogTraceln(v ...any) {}
+
+func (l *Logger) LogTracew(msg string, keysAndValues ...any) {}
+
+func (l *Logger) LogTraceFunc(fn func() string) {}
+
+func (l *Logger) LogDebug(format string, v ...any) {}
+
+func (l *Logger) LogDebugln(v ...any) {}
+
+func (l *Logger) LogDebugw(msg string, keysAndValues ...any) {}
+
+func (l *Logger) LogDebugFunc(fn func() string) {}
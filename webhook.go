@@ -0,0 +1,684 @@
+package logger
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebhookQueueSize       = 256
+	defaultWebhookRetryBackoff    = 500 * time.Millisecond
+	defaultWebhookMaxRetryBackoff = 30 * time.Second
+	defaultWebhookTimeout         = 10 * time.Second
+)
+
+const (
+	WebhookFormatJSON    = "json"
+	WebhookFormatSlack   = "slack"
+	WebhookFormatDiscord = "discord"
+	WebhookFormatTeams   = "teams"
+)
+
+type webhookStatusError struct {
+	status     string
+	statusCode int
+}
+
+func (e *webhookStatusError) Error() string {
+	return "webhook responded with status: " + e.status
+}
+
+type WebhookConfig struct {
+	Url            string
+	Urls           []string
+	SendError      bool
+	SendFatal      bool
+	SendWarn       bool
+	LevelUrls      map[string][]string
+	CodeUrls       map[string][]string
+	QueueSize      int
+	OverflowPolicy string
+
+	MaxRetries      int
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	OnFinalFailure  func(payload []byte, err error)
+
+	OnDeliverySuccess func(url string, payload []byte)
+	OnDeliveryFailure func(url string, payload []byte, err error)
+
+	HTTPClient *http.Client
+	Transport  http.RoundTripper
+	Timeout    time.Duration
+
+	Headers     map[string]string
+	BearerToken string
+	BasicAuth   *BasicAuth
+
+	SigningSecret string
+	Compress      bool
+
+	TLS      *WebhookTLSConfig
+	ProxyURL string
+
+	CircuitBreaker *CircuitBreaker
+
+	SpoolDir            string
+	SpoolMaxFiles       int
+	SpoolReplayInterval time.Duration
+
+	DigestWindow time.Duration
+
+	Format          string
+	IncludeFields   bool
+	IncludeHostname bool
+	IncludeStack    bool
+	IncludeSeverity bool
+	Template        string
+	ContentType     string
+	PayloadBuilder  PayloadBuilder
+}
+
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+type WebhookEndpointStatus struct {
+	URL         string
+	Sent        int
+	Failed      int
+	LastError   string
+	LastSuccess time.Time
+	LastFailure time.Time
+}
+
+type WebhookStats struct {
+	Sent       int
+	Failed     int
+	Retried    int
+	Dropped    int
+	QueueDepth int
+	Endpoints  []WebhookEndpointStatus
+}
+
+type webhookJob struct {
+	config      WebhookConfig
+	url         string
+	contentType string
+	payload     []byte
+	notifier    Notifier
+	entry       Entry
+	replayed    bool
+	attempt     int
+}
+
+type webhookDispatcher struct {
+	logger    *Logger
+	ctx       context.Context
+	cancel    context.CancelFunc
+	lanes     map[any]chan webhookJob
+	size      int
+	policy    string
+	mu        sync.Mutex
+	idle      *sync.Cond
+	pending   int
+	endpoints map[string]*WebhookEndpointStatus
+	breakers  map[string]*breakerState
+	stats     WebhookStats
+
+	replayCancel context.CancelFunc
+	replayDone   chan struct{}
+}
+
+func (l *Logger) sendWebhook(entry Entry) {
+	if len(l.WebhookConfig.urlsForEntry(entry)) == 0 {
+		return
+	}
+
+	if entry.Stack == nil && l.WebhookConfig.IncludeStack && levelRank(entry.Level) >= levelRank(ERR) {
+		entry.Stack = captureStack()
+	}
+	if _, routed := l.WebhookConfig.codeUrls(entry); !routed && l.WebhookConfig.DigestWindow > 0 && (entry.Level == WARN || entry.Level == ERR) {
+		l.webhookDigest().add(entry)
+		return
+	}
+	l.deliverWebhook(entry)
+}
+
+func (l *Logger) deliverWebhook(entry Entry) {
+	urls := l.WebhookConfig.urlsForEntry(entry)
+	if len(urls) == 0 {
+		return
+	}
+
+	contentType, payload, err := l.WebhookConfig.buildPayload(entry)
+	if err != nil {
+		l.logInternal("Failed to build webhook payload: %v\n", err)
+		return
+	}
+
+	d := l.webhooks()
+	for _, url := range urls {
+		d.enqueue(webhookJob{config: l.WebhookConfig, url: url, contentType: contentType, payload: payload, entry: entry})
+	}
+}
+
+func (c WebhookConfig) buildPayload(entry Entry) (string, []byte, error) {
+	return c.payloadBuilder().Build(entry)
+}
+
+func (c WebhookConfig) payloadBuilder() PayloadBuilder {
+	if c.PayloadBuilder != nil {
+		return c.PayloadBuilder
+	}
+	if c.Template != "" {
+		return TemplatePayload{Template: c.Template, ContentType: c.ContentType}
+	}
+	switch c.Format {
+	case WebhookFormatSlack:
+		return SlackPayload{}
+	case WebhookFormatDiscord:
+		return DiscordPayload{}
+	case WebhookFormatTeams:
+		return TeamsPayload{}
+	default:
+		return JSONPayload{
+			IncludeFields:   c.IncludeFields,
+			IncludeHostname: c.IncludeHostname,
+			IncludeStack:    c.IncludeStack,
+			IncludeSeverity: c.IncludeSeverity,
+		}
+	}
+}
+
+func (c WebhookConfig) sends(logLevel string) bool {
+	if len(c.urlsFor(logLevel)) == 0 {
+		return false
+	}
+	switch logLevel {
+	case WARN:
+		if c.SendWarn {
+			return true
+		}
+	case ERR:
+		if c.SendError {
+			return true
+		}
+	case FATAL:
+		if c.SendFatal {
+			return true
+		}
+	}
+	_, routed := c.levelUrls(logLevel)
+	return routed
+}
+
+func (c WebhookConfig) urlsFor(logLevel string) []string {
+	if urls, ok := c.levelUrls(logLevel); ok {
+		return urls
+	}
+	return c.urls()
+}
+
+func (c WebhookConfig) levelUrls(logLevel string) ([]string, bool) {
+	if urls, ok := c.LevelUrls[logLevel]; ok {
+		return urls, true
+	}
+	if urls, ok := c.LevelUrls[ERR]; ok && logLevel == FATAL {
+		return urls, true
+	}
+	return nil, false
+}
+
+func (c WebhookConfig) urlsForEntry(entry Entry) []string {
+	if urls, ok := c.codeUrls(entry); ok {
+		return urls
+	}
+	return c.urlsFor(entry.Level)
+}
+
+func (c WebhookConfig) urls() []string {
+	urls := make([]string, 0, len(c.Urls)+1)
+	if c.Url != "" {
+		urls = append(urls, c.Url)
+	}
+	for _, u := range c.Urls {
+		if u != "" && !slices.Contains(urls, u) {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+func (l *Logger) WebhookStats() WebhookStats {
+	d := l.currentWebhooks()
+	if d == nil {
+		return WebhookStats{}
+	}
+
+	d.mu.Lock()
+	stats := d.stats
+	stats.QueueDepth = d.pending
+	d.mu.Unlock()
+	stats.Endpoints = l.WebhookEndpoints()
+	return stats
+}
+
+func (l *Logger) WebhookEndpoints() []WebhookEndpointStatus {
+	d := l.currentWebhooks()
+	if d == nil {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	statuses := make([]WebhookEndpointStatus, 0, len(d.endpoints))
+	for _, status := range d.endpoints {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].URL < statuses[j].URL
+	})
+	return statuses
+}
+
+func (l *Logger) Flush() {
+	if digest := l.currentDigest(); digest != nil {
+		digest.flush()
+	}
+	if d := l.currentWebhooks(); d != nil {
+		d.wait()
+	}
+	l.flushNotifierDigests(context.Background())
+}
+
+func (l *Logger) flushNotifierDigests(ctx context.Context) {
+	for _, n := range l.Notifiers {
+		if flusher, ok := n.(interface {
+			FlushDigestContext(ctx context.Context) error
+		}); ok {
+			if err := flusher.FlushDigestContext(ctx); err != nil {
+				l.logInternal("Failed to flush notifier digest: %v\n", err)
+			}
+		}
+	}
+}
+
+func (l *Logger) Shutdown(ctx context.Context) error {
+	if digest := l.currentDigest(); digest != nil {
+		digest.flush()
+	}
+	d := l.currentWebhooks()
+	if d == nil {
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		d.stopReplay()
+		l.flushNotifierDigests(ctx)
+		return nil
+	case <-ctx.Done():
+		d.abort()
+		d.stopReplay()
+		return ctx.Err()
+	}
+}
+
+func (l *Logger) currentWebhooks() *webhookDispatcher {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if l.state == nil {
+		return nil
+	}
+	return l.state.webhooks
+}
+
+func (l *Logger) webhooks() *webhookDispatcher {
+	state := l.shared()
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if state.webhooks == nil {
+		size := l.WebhookConfig.QueueSize
+		if size <= 0 {
+			size = defaultWebhookQueueSize
+		}
+		d := &webhookDispatcher{
+			logger:    l,
+			lanes:     make(map[any]chan webhookJob),
+			size:      size,
+			policy:    l.WebhookConfig.OverflowPolicy,
+			endpoints: make(map[string]*WebhookEndpointStatus),
+			breakers:  make(map[string]*breakerState),
+		}
+		d.ctx, d.cancel = context.WithCancel(context.Background())
+		d.idle = sync.NewCond(&d.mu)
+		state.webhooks = d
+	}
+	return state.webhooks
+}
+
+func (d *webhookDispatcher) enqueue(job webhookJob) {
+	d.mu.Lock()
+	d.pending++
+	d.mu.Unlock()
+
+	offer(d.lane(job), job, d.policy, d.drop)
+}
+
+func (d *webhookDispatcher) lane(job webhookJob) chan webhookJob {
+	var key any = job.url
+	if job.notifier != nil {
+		key = job.notifier
+		if !reflect.TypeOf(job.notifier).Comparable() {
+			key = reflect.TypeOf(job.notifier)
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	queue, ok := d.lanes[key]
+	if !ok {
+		queue = make(chan webhookJob, d.size)
+		d.lanes[key] = queue
+		go d.run(queue)
+	}
+	return queue
+}
+
+func (d *webhookDispatcher) drop(job webhookJob) {
+	d.done()
+	d.mu.Lock()
+	d.stats.Dropped++
+	d.mu.Unlock()
+	d.logger.logInternal("Webhook queue is full, dropping payload\n")
+	if !job.replayed {
+		d.logger.drop(job.entry, DropQueueFull)
+	}
+}
+
+func (d *webhookDispatcher) context() context.Context {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.ctx
+}
+
+func (d *webhookDispatcher) abort() {
+	d.mu.Lock()
+	cancel := d.cancel
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	lanes := make([]chan webhookJob, 0, len(d.lanes))
+	for _, queue := range d.lanes {
+		lanes = append(lanes, queue)
+	}
+	d.mu.Unlock()
+	cancel()
+
+	for _, queue := range lanes {
+		drain(queue, func(webhookJob) {
+			d.done()
+			d.mu.Lock()
+			d.stats.Dropped++
+			d.mu.Unlock()
+		})
+	}
+}
+
+func drain(queue chan webhookJob, discard func(webhookJob)) {
+	for {
+		select {
+		case job := <-queue:
+			discard(job)
+		default:
+			return
+		}
+	}
+}
+
+func (d *webhookDispatcher) run(queue chan webhookJob) {
+	for job := range queue {
+		if job.notifier != nil {
+			d.notify(job)
+		} else {
+			d.deliverTo(job, job.url)
+		}
+		d.done()
+	}
+}
+
+func (d *webhookDispatcher) done() {
+	d.mu.Lock()
+	d.pending--
+	if d.pending == 0 {
+		d.idle.Broadcast()
+	}
+	d.mu.Unlock()
+}
+
+func (d *webhookDispatcher) wait() {
+	d.mu.Lock()
+	for d.pending > 0 {
+		d.idle.Wait()
+	}
+	d.mu.Unlock()
+}
+
+func (d *webhookDispatcher) deliverTo(job webhookJob, url string) {
+	breaker := job.config.CircuitBreaker
+	if breaker != nil && !d.breakerAllows(url, breaker) {
+		d.record(url, ErrCircuitOpen)
+		d.fail(job, url, ErrCircuitOpen)
+		return
+	}
+
+	ctx := d.context()
+	var err error
+	for attempt := 0; ; attempt++ {
+		started := time.Now()
+		err = post(ctx, job, url)
+		d.logger.recordWebhookLatency(url, time.Since(started), err)
+		if err == nil {
+			break
+		}
+		if attempt >= job.config.MaxRetries || !retryable(err) || !sleepContext(ctx, job.config.backoff(attempt)) {
+			break
+		}
+		d.mu.Lock()
+		d.stats.Retried++
+		d.mu.Unlock()
+	}
+	if breaker != nil {
+		d.breakerResult(url, breaker, err)
+	}
+	d.record(url, err)
+	if err == nil {
+		if job.config.OnDeliverySuccess != nil {
+			job.config.OnDeliverySuccess(url, job.payload)
+		}
+		if job.config.SpoolDir != "" && !job.replayed {
+			d.startReplay(job.config, url)
+		}
+		return
+	}
+
+	d.logger.logInternal("Failed to send webhook to %s: %v\n", url, err)
+	d.fail(job, url, err)
+}
+
+func (d *webhookDispatcher) fail(job webhookJob, url string, err error) {
+	if job.config.OnDeliveryFailure != nil {
+		job.config.OnDeliveryFailure(url, job.payload, err)
+	}
+	if job.config.OnFinalFailure != nil {
+		job.config.OnFinalFailure(job.payload, err)
+	}
+	if job.config.SpoolDir != "" && !job.replayed {
+		if spoolErr := spool(job, url); spoolErr != nil {
+			d.logger.logInternal("Failed to spool webhook payload: %v\n", spoolErr)
+		}
+	}
+}
+
+func (d *webhookDispatcher) record(url string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	status, ok := d.endpoints[url]
+	if !ok {
+		status = &WebhookEndpointStatus{URL: url}
+		d.endpoints[url] = status
+	}
+	if err != nil {
+		d.stats.Failed++
+		status.Failed++
+		status.LastError = err.Error()
+		status.LastFailure = time.Now()
+		return
+	}
+	d.stats.Sent++
+	status.Sent++
+	status.LastSuccess = time.Now()
+}
+
+func post(ctx context.Context, job webhookJob, url string) error {
+	timeout := job.config.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body := job.payload
+	if job.config.Compress {
+		compressed, err := gzipBytes(body)
+		if err != nil {
+			return err
+		}
+		body = compressed
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", job.contentType)
+	if job.config.Compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	job.config.authorize(req)
+	if job.config.SigningSecret != "" {
+		req.Header.Set("X-Signature", "sha256="+Sign(job.config.SigningSecret, body))
+	}
+
+	client, err := job.config.client()
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(body io.ReadCloser) {
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &webhookStatusError{status: resp.Status, statusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifySignature(secret string, payload []byte, signature string) bool {
+	expected := "sha256=" + Sign(secret, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (c WebhookConfig) authorize(req *http.Request) {
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+	if c.BasicAuth != nil {
+		req.SetBasicAuth(c.BasicAuth.Username, c.BasicAuth.Password)
+	}
+	if c.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.BearerToken)
+	}
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func retryable(err error) bool {
+	var statusErr *webhookStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.statusCode >= 500 || statusErr.statusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+func (c WebhookConfig) backoff(attempt int) time.Duration {
+	base := c.RetryBackoff
+	if base <= 0 {
+		base = defaultWebhookRetryBackoff
+	}
+	maxBackoff := c.MaxRetryBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultWebhookMaxRetryBackoff
+	}
+
+	backoff := maxBackoff
+	if attempt < 32 {
+		if b := base << attempt; b > 0 && b < maxBackoff {
+			backoff = b
+		}
+	}
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+)
+
+var (
+	defaultWebhookClient = &http.Client{}
+	webhookClients       sync.Map
+)
+
+type WebhookTLSConfig struct {
+	CertFile           string
+	KeyFile            string
+	CAFile             string
+	Certificates       []tls.Certificate
+	RootCAs            *x509.CertPool
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+type webhookClientKey struct {
+	tls   *WebhookTLSConfig
+	proxy string
+}
+
+func (c WebhookConfig) client() (*http.Client, error) {
+	if c.HTTPClient != nil {
+		return c.HTTPClient, nil
+	}
+	if c.Transport != nil {
+		return &http.Client{Transport: c.Transport}, nil
+	}
+	if c.TLS == nil && c.ProxyURL == "" {
+		return defaultWebhookClient, nil
+	}
+
+	key := webhookClientKey{tls: c.TLS, proxy: c.ProxyURL}
+	if client, ok := webhookClients.Load(key); ok {
+		return client.(*http.Client), nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.build()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	if c.ProxyURL != "" {
+		proxy, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	client, _ := webhookClients.LoadOrStore(key, &http.Client{Transport: transport})
+	return client.(*http.Client), nil
+}
+
+func (c *WebhookTLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		RootCAs:            c.RootCAs,
+		Certificates:       c.Certificates,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig.RootCAs == nil {
+			tlsConfig.RootCAs = x509.NewCertPool()
+		} else {
+			tlsConfig.RootCAs = tlsConfig.RootCAs.Clone()
+		}
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in webhook CA file")
+		}
+	}
+	return tlsConfig, nil
+}
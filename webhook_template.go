@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+var webhookTemplates sync.Map
+
+var webhookTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+type WebhookTemplateData struct {
+	ServiceName    string
+	LogContextName string
+	Level          string
+	Message        string
+	Timestamp      string
+	Time           time.Time
+	Fields         Fields
+}
+
+type TemplatePayload struct {
+	Template    string
+	ContentType string
+}
+
+func (p TemplatePayload) Build(entry Entry) (string, []byte, error) {
+	tmpl, err := parseWebhookTemplate(p.Template)
+	if err != nil {
+		return "", nil, err
+	}
+
+	data := WebhookTemplateData{
+		ServiceName:    entry.Service,
+		LogContextName: entry.Context,
+		Level:          entry.Level,
+		Message:        entry.Message,
+		Timestamp:      entry.Time.Format(time.RFC3339),
+		Time:           entry.Time,
+		Fields:         entry.Fields,
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", nil, err
+	}
+
+	contentType := p.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	return contentType, buf.Bytes(), nil
+}
+
+func parseWebhookTemplate(text string) (*template.Template, error) {
+	if tmpl, ok := webhookTemplates.Load(text); ok {
+		return tmpl.(*template.Template), nil
+	}
+	tmpl, err := template.New("webhook").Funcs(webhookTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	webhookTemplates.Store(text, tmpl)
+	return tmpl, nil
+}
@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSignKnownVectors(t *testing.T) {
+	tests := []struct {
+		secret  string
+		payload string
+		want    string
+	}{
+		{"Jefe", "what do ya want for nothing?", "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{"whsec", `{"level":"ERROR"}`, "56ed26902ecf3e31e35eb6fde9fe934f2f9bb2b649a57a6d4bd8bbe6a116c67a"},
+	}
+	for _, tt := range tests {
+		if got := Sign(tt.secret, []byte(tt.payload)); got != tt.want {
+			t.Errorf("Sign(%q, %q) = %s, want %s", tt.secret, tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"level":"ERROR"}`)
+	valid := "sha256=56ed26902ecf3e31e35eb6fde9fe934f2f9bb2b649a57a6d4bd8bbe6a116c67a"
+
+	tests := []struct {
+		name      string
+		secret    string
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{"valid", "whsec", payload, valid, true},
+		{"wrong secret", "other", payload, valid, false},
+		{"modified payload", "whsec", []byte(`{"level":"FATAL"}`), valid, false},
+		{"missing prefix", "whsec", payload, valid[len("sha256="):], false},
+		{"uppercase hex", "whsec", payload, "sha256=56ED26902ECF3E31E35EB6FDE9FE934F2F9BB2B649A57A6D4BD8BBE6A116C67A", false},
+		{"truncated", "whsec", payload, valid[:len(valid)-2], false},
+		{"empty", "whsec", payload, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostSignsDeliveredBody(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		var verified bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			verified = VerifySignature("whsec", body, r.Header.Get("X-Signature"))
+		}))
+
+		job := webhookJob{
+			config:      WebhookConfig{SigningSecret: "whsec", Compress: compress},
+			contentType: "application/json",
+			payload:     []byte(`{"level":"ERROR","message":"payment failed"}`),
+		}
+		if err := post(context.Background(), job, srv.URL); err != nil {
+			t.Fatalf("post(compress=%v): %v", compress, err)
+		}
+		srv.Close()
+		if !verified {
+			t.Errorf("compress=%v: X-Signature does not verify against the delivered body", compress)
+		}
+	}
+}
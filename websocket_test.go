@@ -0,0 +1,226 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testWebSocketConn(in []byte) (*websocketConn, *bytes.Buffer) {
+	var out bytes.Buffer
+	rw := bufio.NewReadWriter(bufio.NewReader(bytes.NewReader(in)), bufio.NewWriter(&out))
+	return &websocketConn{rw: rw}, &out
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestWebSocketReadFrameKnownVectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		frame   string
+		opcode  byte
+		payload string
+	}{
+		{"masked text", "81 85 37fa213d 7f9f4d5158", websocketText, "Hello"},
+		{"masked ping", "89 85 37fa213d 7f9f4d5158", websocketPing, "Hello"},
+		{"empty close", "88 80 00000000", websocketClose, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, _ := testWebSocketConn(mustHex(t, tt.frame))
+			opcode, payload, err := ws.readFrame()
+			if err != nil {
+				t.Fatalf("readFrame: %v", err)
+			}
+			if opcode != tt.opcode || string(payload) != tt.payload {
+				t.Errorf("readFrame = %#x %q, want %#x %q", opcode, payload, tt.opcode, tt.payload)
+			}
+		})
+	}
+}
+
+func TestWebSocketReadFrameExtendedLength(t *testing.T) {
+	payload := bytes.Repeat([]byte("a"), 300)
+	mask := []byte{1, 2, 3, 4}
+	frame := append([]byte{0x81, 0x80 | 126, 0x01, 0x2c}, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	ws, _ := testWebSocketConn(frame)
+	_, got, err := ws.readFrame()
+	if err != nil {
+		t.Fatalf("readFrame: %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Errorf("readFrame payload = %q, want 300 bytes of 'a'", got)
+	}
+}
+
+func TestWebSocketReadFrameRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame string
+		want  error
+	}{
+		{"unmasked client frame", "81 05 48656c6c6f", errWebSocketProtocol},
+		{"fragmented frame", "01 85 37fa213d 7f9f4d5158", errWebSocketProtocol},
+		{"oversized payload", "81 ff 0000000000010001 37fa213d", errWebSocketProtocol},
+		{"truncated payload", "81 85 37fa213d 7f9f", io.ErrUnexpectedEOF},
+		{"truncated header", "81", io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, _ := testWebSocketConn(mustHex(t, tt.frame))
+			if _, _, err := ws.readFrame(); !errors.Is(err, tt.want) {
+				t.Errorf("readFrame error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebSocketWriteFrameKnownVectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opcode  byte
+		payload []byte
+		header  string
+	}{
+		{"small text", websocketText, []byte("Hello"), "81 05"},
+		{"pong", websocketPong, []byte("Hello"), "8a 05"},
+		{"16-bit length", websocketText, bytes.Repeat([]byte("a"), 256), "81 7e 0100"},
+		{"64-bit length", websocketText, bytes.Repeat([]byte("a"), 65536), "81 7f 0000000000010000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, out := testWebSocketConn(nil)
+			if err := ws.writeFrame(tt.opcode, tt.payload); err != nil {
+				t.Fatalf("writeFrame: %v", err)
+			}
+			want := append(mustHex(t, tt.header), tt.payload...)
+			if !bytes.Equal(out.Bytes(), want) {
+				t.Errorf("writeFrame header = % x, want %s", out.Bytes()[:min(out.Len(), 10)], tt.header)
+			}
+		})
+	}
+}
+
+func dialWebSocket(t *testing.T, srv *httptest.Server, header string) (net.Conn, *bufio.Reader, *http.Response) {
+	t.Helper()
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	request := "GET /?level=WARN HTTP/1.1\r\nHost: " + srv.Listener.Addr().String() + "\r\n" +
+		"Upgrade: websocket\r\nConnection: keep-alive, Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n" + header + "\r\n"
+	if _, err := io.WriteString(conn, request); err != nil {
+		t.Fatal(err)
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, reader, resp
+}
+
+func TestWebSocketHandler(t *testing.T) {
+	broadcaster := &Broadcaster{}
+	srv := httptest.NewServer(broadcaster.WebSocketHandler())
+	defer srv.Close()
+
+	conn, reader, resp := dialWebSocket(t, srv, "")
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("status = %s, want 101", resp.Status)
+	}
+	if got, want := resp.Header.Get("Sec-WebSocket-Accept"), "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="; got != want {
+		t.Fatalf("Sec-WebSocket-Accept = %q, want %q", got, want)
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		broadcaster.mu.Lock()
+		subscribed := len(broadcaster.subscribers) > 0
+		broadcaster.mu.Unlock()
+		if subscribed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("handler never subscribed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	readServerFrame := func() (byte, []byte) {
+		t.Helper()
+		var header [2]byte
+		if _, err := io.ReadFull(reader, header[:]); err != nil {
+			t.Fatal(err)
+		}
+		if header[0]&0x80 == 0 || header[1]&0x80 != 0 || header[1] >= 126 {
+			t.Fatalf("unexpected server frame header % x", header)
+		}
+		payload := make([]byte, header[1])
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			t.Fatal(err)
+		}
+		return header[0] & 0x0f, payload
+	}
+
+	_ = broadcaster.Write(Entry{Level: INFO, Message: "filtered out"})
+	_ = broadcaster.Write(Entry{Level: ERR, Message: "disk full"})
+	if opcode, payload := readServerFrame(); opcode != websocketText || !strings.Contains(string(payload), `"disk full"`) {
+		t.Fatalf("got frame %#x %s, want the ERROR entry", opcode, payload)
+	}
+
+	if _, err := conn.Write(mustHex(t, "89 85 37fa213d 7f9f4d5158")); err != nil {
+		t.Fatal(err)
+	}
+	if opcode, payload := readServerFrame(); opcode != websocketPong || string(payload) != "Hello" {
+		t.Fatalf("got frame %#x %q, want pong \"Hello\"", opcode, payload)
+	}
+
+	_ = broadcaster.Close()
+	if opcode, payload := readServerFrame(); opcode != websocketClose || !bytes.Equal(payload, []byte{0x03, 0xe9}) {
+		t.Fatalf("got frame %#x % x, want close 1001", opcode, payload)
+	}
+}
+
+func TestWebSocketHandlerRejectsCrossOrigin(t *testing.T) {
+	broadcaster := &Broadcaster{AllowedOrigins: []string{"https://ops.example.com"}}
+	srv := httptest.NewServer(broadcaster.WebSocketHandler())
+	defer srv.Close()
+
+	tests := []struct {
+		origin string
+		want   int
+	}{
+		{"https://evil.example.com", http.StatusForbidden},
+		{"https://ops.example.com", http.StatusSwitchingProtocols},
+		{"http://" + srv.Listener.Addr().String(), http.StatusSwitchingProtocols},
+	}
+	for _, tt := range tests {
+		_, _, resp := dialWebSocket(t, srv, "Origin: "+tt.origin+"\r\n")
+		if resp.StatusCode != tt.want {
+			t.Errorf("Origin %s: status = %d, want %d", tt.origin, resp.StatusCode, tt.want)
+		}
+	}
+}